@@ -0,0 +1,228 @@
+package gosumsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const defaultAccessTokenRefreshBefore = time.Minute
+
+var (
+	ErrAccessTokenSubjectRequired = errors.New("access token subject is required")
+	ErrInvalidAccessTokenSubject  = errors.New("access token subject has no user ID or level name")
+)
+
+type AccessTokenSubject struct {
+	UserID               string
+	LevelName            string
+	ApplicantIdentifiers *ApplicantIdentifiers
+}
+
+// AccessTokenResolver maps an incoming (already authenticated) request to the Sumsub user and level
+// the token is issued for. Returning an error rejects the request with 401; a subject without a user
+// ID or level name is a resolver bug and answers 500.
+type AccessTokenResolver func(request *http.Request) (*AccessTokenSubject, error)
+
+type AccessTokenHandlerOption func(*AccessTokenHandler)
+
+type accessTokenCacheKey struct {
+	userID    string
+	levelName string
+}
+
+type AccessTokenHandler struct {
+	client        *Client
+	resolver      AccessTokenResolver
+	ttl           time.Duration
+	refreshBefore time.Duration
+	locks         *keyedMutex
+	mu            sync.Mutex
+	cache         map[accessTokenCacheKey]*SDKAccessToken
+}
+
+func NewAccessTokenHandler(
+	client *Client,
+	resolver AccessTokenResolver,
+	opts ...AccessTokenHandlerOption,
+) *AccessTokenHandler {
+	handler := &AccessTokenHandler{
+		client:        client,
+		resolver:      resolver,
+		ttl:           defaultSDKAccessTokenTTL,
+		refreshBefore: defaultAccessTokenRefreshBefore,
+		locks:         newKeyedMutex(),
+		mu:            sync.Mutex{},
+		cache:         make(map[accessTokenCacheKey]*SDKAccessToken),
+	}
+
+	for _, opt := range opts {
+		opt(handler)
+	}
+
+	return handler
+}
+
+func WithAccessTokenTTL(ttl time.Duration) AccessTokenHandlerOption {
+	return func(h *AccessTokenHandler) {
+		if ttl > 0 {
+			h.ttl = ttl
+		}
+	}
+}
+
+// WithAccessTokenRefreshBefore sets how long before expiry a cached token stops being reused.
+func WithAccessTokenRefreshBefore(refreshBefore time.Duration) AccessTokenHandlerOption {
+	return func(h *AccessTokenHandler) {
+		if refreshBefore >= 0 {
+			h.refreshBefore = refreshBefore
+		}
+	}
+}
+
+func (h *AccessTokenHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	subject, err := h.resolve(request)
+	if errors.Is(err, ErrInvalidAccessTokenSubject) {
+		http.Error(writer, "invalid access token subject", http.StatusInternalServerError)
+
+		return
+	}
+
+	if err != nil {
+		http.Error(writer, "unauthorized", http.StatusUnauthorized)
+
+		return
+	}
+
+	token, err := h.Token(request.Context(), subject)
+	if err != nil {
+		http.Error(writer, "failed to generate access token", http.StatusBadGateway)
+
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Header().Set("Cache-Control", "no-store")
+	writer.WriteHeader(http.StatusOK)
+
+	_ = json.NewEncoder(writer).Encode(token)
+}
+
+// Token returns a cached token for subject, or generates a new one when there is none or the cached
+// token is about to expire.
+func (h *AccessTokenHandler) Token(ctx context.Context, subject *AccessTokenSubject) (*SDKAccessToken, error) {
+	if err := validateAccessTokenSubject(subject); err != nil {
+		return nil, err
+	}
+
+	key := accessTokenCacheKey{userID: subject.UserID, levelName: subject.LevelName}
+
+	unlock := h.locks.lock(key.userID + "\x00" + key.levelName)
+	defer unlock()
+
+	if token := h.cached(key); token != nil {
+		return token, nil
+	}
+
+	token, err := h.client.GenerateSDKAccessToken(
+		ctx,
+		subject.UserID,
+		subject.LevelName,
+		h.ttl,
+		subject.ApplicantIdentifiers,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	h.store(key, token)
+
+	return token, nil
+}
+
+func (h *AccessTokenHandler) resolve(request *http.Request) (*AccessTokenSubject, error) {
+	subject, err := h.resolver(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateAccessTokenSubject(subject); err != nil {
+		return nil, err
+	}
+
+	return subject, nil
+}
+
+func validateAccessTokenSubject(subject *AccessTokenSubject) error {
+	if subject == nil {
+		return ErrAccessTokenSubjectRequired
+	}
+
+	if subject.UserID == "" || subject.LevelName == "" {
+		return ErrInvalidAccessTokenSubject
+	}
+
+	return nil
+}
+
+func (h *AccessTokenHandler) cached(key accessTokenCacheKey) *SDKAccessToken {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	token, ok := h.cache[key]
+	if !ok || !h.fresh(token) {
+		return nil
+	}
+
+	return token
+}
+
+func (h *AccessTokenHandler) store(key accessTokenCacheKey, token *SDKAccessToken) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for cachedKey, cachedToken := range h.cache {
+		if !h.fresh(cachedToken) {
+			delete(h.cache, cachedKey)
+		}
+	}
+
+	h.cache[key] = token
+}
+
+func (h *AccessTokenHandler) fresh(token *SDKAccessToken) bool {
+	return h.client.clock().Add(h.refreshBefore).Before(token.ExpiresAt)
+}
+
+func EchoAccessTokenHandler(
+	client *Client,
+	resolver AccessTokenResolver,
+	opts ...AccessTokenHandlerOption,
+) echo.HandlerFunc {
+	handler := NewAccessTokenHandler(client, resolver, opts...)
+
+	return func(ctx echo.Context) error {
+		subject, err := handler.resolve(ctx.Request())
+		if errors.Is(err, ErrInvalidAccessTokenSubject) {
+			return ctx.String(http.StatusInternalServerError, "invalid access token subject")
+		}
+
+		if err != nil {
+			return ctx.String(http.StatusUnauthorized, "unauthorized")
+		}
+
+		token, err := handler.Token(ctx.Request().Context(), subject)
+		if err != nil {
+			return ctx.String(http.StatusBadGateway, "failed to generate access token")
+		}
+
+		ctx.Response().Header().Set("Cache-Control", "no-store")
+
+		return ctx.JSON(http.StatusOK, token)
+	}
+}
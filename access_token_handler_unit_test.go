@@ -0,0 +1,287 @@
+package gosumsub_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/andyle182810/gosumsub"
+	"github.com/labstack/echo/v4"
+)
+
+var errNotLoggedIn = errors.New("not logged in")
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func newAccessTokenTestClient(t *testing.T, clock *fakeClock) (*gosumsub.Client, *recordingHTTPClient) {
+	t.Helper()
+
+	httpClient := newRecordingHTTPClient(func(req *http.Request) (*http.Response, error) {
+		var body struct {
+			UserID string `json:"userId"`
+		}
+
+		_ = json.NewDecoder(req.Body).Decode(&body)
+
+		return newJSONResponse(http.StatusOK, `{"token":"token-for-`+body.UserID+`","userId":"`+body.UserID+`"}`), nil
+	})
+
+	client, err := gosumsub.NewClient(
+		"https://api.example.com",
+		"test-token",
+		"test-secret",
+		gosumsub.WithHTTPClient(httpClient),
+		gosumsub.WithSigner(&mockSigner{signature: "test-signature", err: nil}),
+		gosumsub.WithClock(clock.Now),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	return client, httpClient
+}
+
+func headerResolver(request *http.Request) (*gosumsub.AccessTokenSubject, error) {
+	userID := request.Header.Get("X-User-Id")
+	if userID == "" {
+		return nil, errNotLoggedIn
+	}
+
+	return &gosumsub.AccessTokenSubject{
+		UserID:               userID,
+		LevelName:            "basic-kyc-level",
+		ApplicantIdentifiers: nil,
+	}, nil
+}
+
+func requestToken(t *testing.T, handler http.Handler, userID string) (*httptest.ResponseRecorder, *gosumsub.SDKAccessToken) {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, "/kyc/token", nil)
+	if userID != "" {
+		req.Header.Set("X-User-Id", userID)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		return rec, nil
+	}
+
+	var token gosumsub.SDKAccessToken
+	if err := json.Unmarshal(rec.Body.Bytes(), &token); err != nil {
+		t.Fatalf("failed to decode token response: %v", err)
+	}
+
+	return rec, &token
+}
+
+func TestAccessTokenHandler_IssuesAndCachesToken(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{mu: sync.Mutex{}, now: time.Unix(1700000000, 0)}
+	client, httpClient := newAccessTokenTestClient(t, clock)
+	handler := gosumsub.NewAccessTokenHandler(client, headerResolver, gosumsub.WithAccessTokenTTL(10*time.Minute))
+
+	rec, first := requestToken(t, handler, "user-1")
+	if first == nil {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+
+	if first.Token != "token-for-user-1" {
+		t.Errorf("unexpected token %q", first.Token)
+	}
+
+	if rec.Header().Get("Cache-Control") != "no-store" {
+		t.Error("expected Cache-Control: no-store")
+	}
+
+	clock.Advance(5 * time.Minute)
+
+	_, second := requestToken(t, handler, "user-1")
+	if second == nil || second.Token != first.Token {
+		t.Errorf("expected cached token, got %+v", second)
+	}
+
+	if got := len(httpClient.recorded()); got != 1 {
+		t.Errorf("expected 1 upstream request, got %d", got)
+	}
+}
+
+func TestAccessTokenHandler_RefreshesNearExpiry(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{mu: sync.Mutex{}, now: time.Unix(1700000000, 0)}
+	client, httpClient := newAccessTokenTestClient(t, clock)
+	handler := gosumsub.NewAccessTokenHandler(
+		client,
+		headerResolver,
+		gosumsub.WithAccessTokenTTL(10*time.Minute),
+		gosumsub.WithAccessTokenRefreshBefore(2*time.Minute),
+	)
+
+	requestToken(t, handler, "user-1")
+	clock.Advance(8*time.Minute + time.Second)
+
+	_, token := requestToken(t, handler, "user-1")
+	if token == nil {
+		t.Fatal("expected a token")
+	}
+
+	if got := len(httpClient.recorded()); got != 2 {
+		t.Errorf("expected token to be regenerated near expiry, got %d upstream requests", got)
+	}
+
+	if !token.ExpiresAt.Equal(clock.Now().Add(10 * time.Minute)) {
+		t.Errorf("unexpected ExpiresAt %v", token.ExpiresAt)
+	}
+}
+
+func TestAccessTokenHandler_SeparateUsers(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{mu: sync.Mutex{}, now: time.Unix(1700000000, 0)}
+	client, httpClient := newAccessTokenTestClient(t, clock)
+	handler := gosumsub.NewAccessTokenHandler(client, headerResolver)
+
+	_, first := requestToken(t, handler, "user-1")
+	_, second := requestToken(t, handler, "user-2")
+
+	if first == nil || second == nil || first.Token == second.Token {
+		t.Errorf("expected distinct tokens per user, got %+v and %+v", first, second)
+	}
+
+	if got := len(httpClient.recorded()); got != 2 {
+		t.Errorf("expected 2 upstream requests, got %d", got)
+	}
+}
+
+func TestAccessTokenHandler_ResolverError(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{mu: sync.Mutex{}, now: time.Unix(1700000000, 0)}
+	client, httpClient := newAccessTokenTestClient(t, clock)
+	handler := gosumsub.NewAccessTokenHandler(client, headerResolver)
+
+	rec, _ := requestToken(t, handler, "")
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+	}
+
+	if got := len(httpClient.recorded()); got != 0 {
+		t.Errorf("expected no upstream requests, got %d", got)
+	}
+}
+
+func TestAccessTokenHandler_InvalidSubject(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{mu: sync.Mutex{}, now: time.Unix(1700000000, 0)}
+	client, httpClient := newAccessTokenTestClient(t, clock)
+
+	subjects := []*gosumsub.AccessTokenSubject{
+		{UserID: "", LevelName: "basic-kyc-level", ApplicantIdentifiers: nil},
+		{UserID: "user-1", LevelName: "", ApplicantIdentifiers: nil},
+	}
+
+	for _, subject := range subjects {
+		handler := gosumsub.NewAccessTokenHandler(client, func(*http.Request) (*gosumsub.AccessTokenSubject, error) {
+			return subject, nil
+		})
+
+		rec, _ := requestToken(t, handler, "user-1")
+		if rec.Code != http.StatusInternalServerError {
+			t.Errorf("subject %+v: expected status %d, got %d", subject, http.StatusInternalServerError, rec.Code)
+		}
+	}
+
+	if got := len(httpClient.recorded()); got != 0 {
+		t.Errorf("expected no upstream requests, got %d", got)
+	}
+}
+
+func TestAccessTokenHandler_UpstreamError(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+	handler := gosumsub.NewAccessTokenHandler(client, headerResolver)
+
+	rec, _ := requestToken(t, handler, "user-1")
+	if rec.Code != http.StatusBadGateway {
+		t.Errorf("expected status %d, got %d", http.StatusBadGateway, rec.Code)
+	}
+}
+
+func TestEchoAccessTokenHandler_Success(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{mu: sync.Mutex{}, now: time.Unix(1700000000, 0)}
+	client, _ := newAccessTokenTestClient(t, clock)
+	handler := gosumsub.EchoAccessTokenHandler(client, headerResolver)
+
+	req := httptest.NewRequest(http.MethodGet, "/kyc/token", nil)
+	req.Header.Set("X-User-Id", "user-1")
+
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(req, rec)
+
+	if err := handler(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+
+	var token gosumsub.SDKAccessToken
+	if err := json.Unmarshal(rec.Body.Bytes(), &token); err != nil {
+		t.Fatalf("failed to decode token: %v", err)
+	}
+
+	if token.Token != "token-for-user-1" {
+		t.Errorf("unexpected token %q", token.Token)
+	}
+}
+
+func TestEchoAccessTokenHandler_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{mu: sync.Mutex{}, now: time.Unix(1700000000, 0)}
+	client, _ := newAccessTokenTestClient(t, clock)
+	handler := gosumsub.EchoAccessTokenHandler(client, headerResolver)
+
+	req := httptest.NewRequest(http.MethodGet, "/kyc/token", nil)
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(req, rec)
+
+	if err := handler(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+	}
+}
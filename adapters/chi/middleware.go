@@ -0,0 +1,21 @@
+// Package sumsubchi adapts the gosumsub webhook verification to chi routers.
+package sumsubchi
+
+import (
+	"net/http"
+
+	"github.com/andyle182810/gosumsub"
+	"github.com/go-chi/chi/v5"
+)
+
+// WebhookMiddleware verifies Sumsub webhooks for use with chi.Router.Use or chi.Router.With. The
+// verified body stays readable and is available, with the parsed event, through
+// gosumsub.WebhookBodyFromContext and gosumsub.WebhookEventFromContext.
+func WebhookMiddleware(secretKey string, options gosumsub.WebhookMiddlewareOptions) func(http.Handler) http.Handler {
+	return gosumsub.WebhookMiddlewareWithOptions(secretKey, options)
+}
+
+// MountWebhookRouter routes POST requests for pattern to router.
+func MountWebhookRouter(r chi.Router, pattern string, router *gosumsub.WebhookRouter) {
+	r.Method(http.MethodPost, pattern, router)
+}
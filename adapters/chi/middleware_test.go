@@ -0,0 +1,66 @@
+package sumsubchi_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+	sumsubchi "github.com/andyle182810/gosumsub/adapters/chi"
+	"github.com/andyle182810/gosumsub/webhooktest"
+	"github.com/go-chi/chi/v5"
+)
+
+func TestWebhookMiddleware_Conformance(t *testing.T) {
+	t.Parallel()
+
+	webhooktest.RunMiddlewareConformance(t, func(
+		_ *testing.T,
+		secretKey string,
+		options gosumsub.WebhookMiddlewareOptions,
+		observe func(webhooktest.Observation),
+	) func(*http.Request) (*http.Response, error) {
+		router := chi.NewRouter()
+		router.Use(sumsubchi.WebhookMiddleware(secretKey, options))
+		router.Post("/webhook", func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			contextBody, _ := gosumsub.WebhookBodyFromContext(request.Context())
+			event, _ := gosumsub.WebhookEventFromContext(request.Context())
+
+			observe(webhooktest.Observation{Body: body, ContextBody: contextBody, Event: event})
+			writer.WriteHeader(http.StatusOK)
+		})
+
+		return func(request *http.Request) (*http.Response, error) {
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, request)
+
+			return rec.Result(), nil
+		}
+	})
+}
+
+func TestMountWebhookRouter(t *testing.T) {
+	t.Parallel()
+
+	var handled bool
+
+	webhookRouter := gosumsub.NewWebhookRouter(webhooktest.SecretKey)
+	webhookRouter.OnApplicantReviewed(func(_ context.Context, _ *gosumsub.ApplicantReviewedEvent) error {
+		handled = true
+
+		return nil
+	})
+
+	router := chi.NewRouter()
+	sumsubchi.MountWebhookRouter(router, "/webhook", webhookRouter)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, webhooktest.NewSignedRequest(webhooktest.EventPayload, webhooktest.SecretKey))
+
+	if rec.Code != http.StatusOK || !handled {
+		t.Errorf("expected handled event with status 200, got %d (handled %v)", rec.Code, handled)
+	}
+}
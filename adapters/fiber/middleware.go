@@ -0,0 +1,98 @@
+// Package sumsubfiber adapts the gosumsub webhook verification to fiber.
+package sumsubfiber
+
+import (
+	"net/http"
+
+	"github.com/andyle182810/gosumsub"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+)
+
+// Keys under which WebhookMiddleware stores the verified body and the parsed event in fiber locals.
+const (
+	LocalsKeyWebhookBody  = "gosumsub.webhookBody"
+	LocalsKeyWebhookEvent = "gosumsub.webhookEvent"
+)
+
+// WebhookMiddleware verifies Sumsub webhooks. The request is converted to an *http.Request so that
+// options behave as in the net/http middleware. The verified body is stored, with the parsed event,
+// in fiber locals and in the user context; c.Body() stays readable.
+func WebhookMiddleware(secretKey string, options gosumsub.WebhookMiddlewareOptions) fiber.Handler {
+	verifier := gosumsub.NewWebhookVerifier(secretKey, options)
+
+	return func(c *fiber.Ctx) error {
+		request, err := adaptor.ConvertRequest(c, false)
+		if err != nil {
+			return err
+		}
+
+		request = request.WithContext(c.UserContext())
+
+		body, err := verifier.Verify(request)
+		if err != nil {
+			writer := newResponseBuffer()
+			verifier.WriteError(writer, request, err)
+
+			return writer.flush(c)
+		}
+
+		c.SetUserContext(gosumsub.ContextWithWebhook(c.UserContext(), body))
+		c.Locals(LocalsKeyWebhookBody, body)
+
+		if event, ok := gosumsub.WebhookEventFromContext(c.UserContext()); ok {
+			c.Locals(LocalsKeyWebhookEvent, event)
+		}
+
+		return c.Next()
+	}
+}
+
+// WebhookEvent returns the event stored by WebhookMiddleware.
+func WebhookEvent(c *fiber.Ctx) (*gosumsub.WebhookEvent, bool) {
+	event, ok := c.Locals(LocalsKeyWebhookEvent).(*gosumsub.WebhookEvent)
+
+	return event, ok
+}
+
+// WebhookBody returns the verified body stored by WebhookMiddleware.
+func WebhookBody(c *fiber.Ctx) ([]byte, bool) {
+	body, ok := c.Locals(LocalsKeyWebhookBody).([]byte)
+
+	return body, ok
+}
+
+// responseBuffer collects what a gosumsub.WebhookErrorWriter writes so it can be sent through fiber.
+type responseBuffer struct {
+	header http.Header
+	status int
+	body   []byte
+}
+
+func newResponseBuffer() *responseBuffer {
+	return &responseBuffer{header: make(http.Header), status: http.StatusOK, body: nil}
+}
+
+func (b *responseBuffer) Header() http.Header {
+	return b.header
+}
+
+func (b *responseBuffer) WriteHeader(status int) {
+	b.status = status
+}
+
+func (b *responseBuffer) Write(data []byte) (int, error) {
+	b.body = append(b.body, data...)
+
+	return len(data), nil
+}
+
+func (b *responseBuffer) flush(c *fiber.Ctx) error {
+	for key, values := range b.header {
+		for _, value := range values {
+			c.Response().Header.Add(key, value)
+		}
+	}
+
+	return c.Status(b.status).Send(b.body)
+}
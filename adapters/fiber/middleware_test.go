@@ -0,0 +1,49 @@
+package sumsubfiber_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+	sumsubfiber "github.com/andyle182810/gosumsub/adapters/fiber"
+	"github.com/andyle182810/gosumsub/webhooktest"
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestWebhookMiddleware_Conformance(t *testing.T) {
+	t.Parallel()
+
+	webhooktest.RunMiddlewareConformance(t, func(
+		t *testing.T,
+		secretKey string,
+		options gosumsub.WebhookMiddlewareOptions,
+		observe func(webhooktest.Observation),
+	) func(*http.Request) (*http.Response, error) {
+		t.Helper()
+
+		app := fiber.New(fiber.Config{DisableStartupMessage: true})
+		app.Use(sumsubfiber.WebhookMiddleware(secretKey, options))
+		app.Post("/webhook", func(c *fiber.Ctx) error {
+			contextBody, _ := sumsubfiber.WebhookBody(c)
+			event, _ := sumsubfiber.WebhookEvent(c)
+
+			requestBody, _ := gosumsub.WebhookBodyFromContext(c.UserContext())
+			if !bytes.Equal(requestBody, contextBody) {
+				t.Errorf("expected user context body %q, got %q", contextBody, requestBody)
+			}
+
+			observe(webhooktest.Observation{
+				Body:        bytes.Clone(c.Body()),
+				ContextBody: contextBody,
+				Event:       event,
+			})
+
+			return c.SendStatus(http.StatusOK)
+		})
+
+		return func(request *http.Request) (*http.Response, error) {
+			return app.Test(request, -1)
+		}
+	})
+}
@@ -0,0 +1,62 @@
+// Package sumsubgin adapts the gosumsub webhook verification to gin.
+package sumsubgin
+
+import (
+	"github.com/andyle182810/gosumsub"
+	"github.com/gin-gonic/gin"
+)
+
+// Keys under which WebhookMiddleware stores the verified body and the parsed event in gin.Context.
+const (
+	ContextKeyWebhookBody  = "gosumsub.webhookBody"
+	ContextKeyWebhookEvent = "gosumsub.webhookEvent"
+)
+
+// WebhookMiddleware verifies Sumsub webhooks. The verified body stays readable and is stored, with
+// the parsed event, in the gin.Context and in the request context.
+func WebhookMiddleware(secretKey string, options gosumsub.WebhookMiddlewareOptions) gin.HandlerFunc {
+	verifier := gosumsub.NewWebhookVerifier(secretKey, options)
+
+	return func(c *gin.Context) {
+		body, err := verifier.Verify(c.Request)
+		if err != nil {
+			verifier.WriteError(c.Writer, c.Request, err)
+			c.Abort()
+
+			return
+		}
+
+		c.Request = c.Request.WithContext(gosumsub.ContextWithWebhook(c.Request.Context(), body))
+		c.Set(ContextKeyWebhookBody, body)
+
+		if event, ok := gosumsub.WebhookEventFromContext(c.Request.Context()); ok {
+			c.Set(ContextKeyWebhookEvent, event)
+		}
+
+		c.Next()
+	}
+}
+
+// WebhookEvent returns the event stored by WebhookMiddleware.
+func WebhookEvent(c *gin.Context) (*gosumsub.WebhookEvent, bool) {
+	event, ok := c.Get(ContextKeyWebhookEvent)
+	if !ok {
+		return nil, false
+	}
+
+	webhookEvent, ok := event.(*gosumsub.WebhookEvent)
+
+	return webhookEvent, ok
+}
+
+// WebhookBody returns the verified body stored by WebhookMiddleware.
+func WebhookBody(c *gin.Context) ([]byte, bool) {
+	body, ok := c.Get(ContextKeyWebhookBody)
+	if !ok {
+		return nil, false
+	}
+
+	webhookBody, ok := body.([]byte)
+
+	return webhookBody, ok
+}
@@ -0,0 +1,52 @@
+package sumsubgin_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+	sumsubgin "github.com/andyle182810/gosumsub/adapters/gin"
+	"github.com/andyle182810/gosumsub/webhooktest"
+	"github.com/gin-gonic/gin"
+)
+
+func TestWebhookMiddleware_Conformance(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	webhooktest.RunMiddlewareConformance(t, func(
+		t *testing.T,
+		secretKey string,
+		options gosumsub.WebhookMiddlewareOptions,
+		observe func(webhooktest.Observation),
+	) func(*http.Request) (*http.Response, error) {
+		t.Helper()
+
+		engine := gin.New()
+		engine.Use(sumsubgin.WebhookMiddleware(secretKey, options))
+		engine.POST("/webhook", func(c *gin.Context) {
+			body, _ := io.ReadAll(c.Request.Body)
+			contextBody, _ := sumsubgin.WebhookBody(c)
+			event, _ := sumsubgin.WebhookEvent(c)
+
+			requestBody, _ := gosumsub.WebhookBodyFromContext(c.Request.Context())
+			if !bytes.Equal(requestBody, contextBody) {
+				t.Errorf("expected request context body %q, got %q", contextBody, requestBody)
+			}
+
+			observe(webhooktest.Observation{Body: body, ContextBody: contextBody, Event: event})
+			c.Status(http.StatusOK)
+		})
+
+		return func(request *http.Request) (*http.Response, error) {
+			rec := httptest.NewRecorder()
+			engine.ServeHTTP(rec, request)
+
+			return rec.Result(), nil
+		}
+	})
+}
@@ -0,0 +1,130 @@
+package gosumsub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+)
+
+const (
+	HeaderImageID           = "X-Image-Id"
+	HeaderReturnDocWarnings = "X-Return-Doc-Warnings"
+)
+
+const (
+	IDDocSubTypeFrontSide = "FRONT_SIDE"
+	IDDocSubTypeBackSide  = "BACK_SIDE"
+)
+
+var (
+	ErrIDDocTypeRequired = errors.New("idDocType is required")
+	ErrCountryRequired   = errors.New("country is required")
+	ErrFileRequired      = errors.New("file is required")
+	ErrFilenameRequired  = errors.New("filename is required")
+	ErrMultipartEncode   = errors.New("failed to encode multipart body")
+)
+
+type AddIDDocumentResponse struct {
+	IDDoc
+
+	ImageID  string   `json:"-"`
+	Errors   []string `json:"errors,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+func (c *Client) AddIDDocument(
+	ctx context.Context,
+	applicantID string,
+	metadata IDDoc,
+	file io.Reader,
+	filename string,
+) (*AddIDDocumentResponse, error) {
+	if applicantID == "" {
+		return nil, ErrApplicantIDRequired
+	}
+
+	if metadata.IDDocType == "" {
+		return nil, ErrIDDocTypeRequired
+	}
+
+	if metadata.Country == "" {
+		return nil, ErrCountryRequired
+	}
+
+	if file == nil {
+		return nil, ErrFileRequired
+	}
+
+	if filename == "" {
+		return nil, ErrFilenameRequired
+	}
+
+	body, contentType, err := encodeIDDocumentMultipart(metadata, file, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+	header.Set(HeaderReturnDocWarnings, "true")
+
+	apiRequest := request{
+		Method:   http.MethodPost,
+		Endpoint: fmt.Sprintf("/resources/applicants/%s/info/idDoc", url.PathEscape(applicantID)),
+		Params:   nil,
+		Query:    nil,
+		Header:   header,
+		Body:     body,
+		FullURL:  "",
+	}
+
+	respBody, respHeader, err := c.executeWithHeader(ctx, &apiRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp AddIDDocumentResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, err
+	}
+
+	resp.ImageID = respHeader.Get(HeaderImageID)
+
+	return &resp, nil
+}
+
+func encodeIDDocumentMultipart(metadata IDDoc, file io.Reader, filename string) (io.Reader, string, error) {
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrRequestEncode, err)
+	}
+
+	var buf bytes.Buffer
+
+	writer := multipart.NewWriter(&buf)
+
+	if err := writer.WriteField("metadata", string(metadataJSON)); err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrMultipartEncode, err)
+	}
+
+	part, err := writer.CreateFormFile("content", filename)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrMultipartEncode, err)
+	}
+
+	if _, err := io.Copy(part, file); err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrMultipartEncode, err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrMultipartEncode, err)
+	}
+
+	return &buf, writer.FormDataContentType(), nil
+}
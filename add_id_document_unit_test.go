@@ -0,0 +1,278 @@
+package gosumsub_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andyle182810/gosumsub"
+	"github.com/andyle182810/gosumsub/signer"
+)
+
+const testAddIDDocumentResponseBody = `{
+	"idDocType": "PASSPORT",
+	"country": "GBR",
+	"warnings": ["badPhoto"]
+}`
+
+func newTestIDDoc(idDocType, country string) gosumsub.IDDoc {
+	return gosumsub.IDDoc{
+		IDDocType:    idDocType,
+		IDDocSubType: "",
+		Country:      country,
+		FirstName:    "",
+		FirstNameEn:  "",
+		LastName:     "",
+		LastNameEn:   "",
+		IssuedDate:   "",
+		ValidUntil:   "",
+		Number:       "",
+		Dob:          "",
+		MrzLine1:     "",
+		MrzLine2:     "",
+		MrzLine3:     "",
+		Termless:     false,
+	}
+}
+
+func newAddIDDocumentHTTPClient() *recordingHTTPClient {
+	return newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		resp := newJSONResponse(http.StatusOK, testAddIDDocumentResponseBody)
+		resp.Header.Set(gosumsub.HeaderImageID, "1336749047")
+
+		return resp, nil
+	})
+}
+
+func TestAddIDDocument_Success(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newAddIDDocumentHTTPClient()
+	client := newMockClient(t, httpClient)
+
+	metadata := newTestIDDoc("PASSPORT", "GBR")
+	metadata.Number = "123456789"
+
+	resp, err := client.AddIDDocument(t.Context(), "app123", metadata, strings.NewReader("image-bytes"), "passport.jpg")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.ImageID != "1336749047" {
+		t.Errorf("expected ImageID '1336749047', got %q", resp.ImageID)
+	}
+
+	if resp.IDDocType != "PASSPORT" {
+		t.Errorf("expected IDDocType 'PASSPORT', got %q", resp.IDDocType)
+	}
+
+	if len(resp.Warnings) != 1 || resp.Warnings[0] != "badPhoto" {
+		t.Errorf("expected warnings [badPhoto], got %v", resp.Warnings)
+	}
+
+	requests := httpClient.recorded()
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(requests))
+	}
+
+	recorded := requests[0]
+	if recorded.url != "https://api.example.com/resources/applicants/app123/info/idDoc" {
+		t.Errorf("unexpected URL %q", recorded.url)
+	}
+
+	if recorded.header.Get(gosumsub.HeaderReturnDocWarnings) != "true" {
+		t.Error("expected X-Return-Doc-Warnings header to be set")
+	}
+
+	mediaType, params, err := mime.ParseMediaType(recorded.header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/form-data" {
+		t.Fatalf("expected multipart/form-data content type, got %q", recorded.header.Get("Content-Type"))
+	}
+
+	form, err := multipart.NewReader(strings.NewReader(string(recorded.body)), params["boundary"]).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatalf("failed to parse multipart body: %v", err)
+	}
+
+	var sentMetadata gosumsub.IDDoc
+	if err := json.Unmarshal([]byte(form.Value["metadata"][0]), &sentMetadata); err != nil {
+		t.Fatalf("failed to decode metadata part: %v", err)
+	}
+
+	if sentMetadata.Number != "123456789" || sentMetadata.Country != "GBR" {
+		t.Errorf("unexpected metadata part: %+v", sentMetadata)
+	}
+
+	files := form.File["content"]
+	if len(files) != 1 || files[0].Filename != "passport.jpg" {
+		t.Fatalf("expected content part named passport.jpg, got %v", files)
+	}
+
+	content, err := files[0].Open()
+	if err != nil {
+		t.Fatalf("failed to open content part: %v", err)
+	}
+
+	defer content.Close()
+
+	data, _ := io.ReadAll(content)
+	if string(data) != "image-bytes" {
+		t.Errorf("expected file content 'image-bytes', got %q", string(data))
+	}
+}
+
+func TestAddIDDocument_SignsMultipartBody(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1234567890, 0)
+	httpClient := newAddIDDocumentHTTPClient()
+
+	client, err := gosumsub.NewClient(
+		"https://api.example.com",
+		"test-token",
+		"test-secret",
+		gosumsub.WithHTTPClient(httpClient),
+		gosumsub.WithClock(func() time.Time { return now }),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	_, err = client.AddIDDocument(
+		t.Context(), "app123", newTestIDDoc("SELFIE", "GBR"), strings.NewReader("selfie"), "selfie.png",
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recorded := httpClient.recorded()[0]
+
+	requestSigner, err := signer.NewSigner("test-secret")
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+
+	expected, err := requestSigner.Sign(now, http.MethodPost, "/resources/applicants/app123/info/idDoc", &recorded.body)
+	if err != nil {
+		t.Fatalf("failed to sign: %v", err)
+	}
+
+	if got := recorded.header.Get("X-App-Access-Sig"); got != expected {
+		t.Errorf("expected signature %q over multipart body, got %q", expected, got)
+	}
+}
+
+func TestAddIDDocument_Validation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		applicantID string
+		metadata    gosumsub.IDDoc
+		file        io.Reader
+		filename    string
+		wantErr     error
+	}{
+		{
+			name:        "missing applicant ID",
+			applicantID: "",
+			metadata:    newTestIDDoc("PASSPORT", "GBR"),
+			file:        strings.NewReader("data"),
+			filename:    "doc.jpg",
+			wantErr:     gosumsub.ErrApplicantIDRequired,
+		},
+		{
+			name:        "missing idDocType",
+			applicantID: "app123",
+			metadata:    newTestIDDoc("", "GBR"),
+			file:        strings.NewReader("data"),
+			filename:    "doc.jpg",
+			wantErr:     gosumsub.ErrIDDocTypeRequired,
+		},
+		{
+			name:        "missing country",
+			applicantID: "app123",
+			metadata:    newTestIDDoc("PASSPORT", ""),
+			file:        strings.NewReader("data"),
+			filename:    "doc.jpg",
+			wantErr:     gosumsub.ErrCountryRequired,
+		},
+		{
+			name:        "missing file",
+			applicantID: "app123",
+			metadata:    newTestIDDoc("PASSPORT", "GBR"),
+			file:        nil,
+			filename:    "doc.jpg",
+			wantErr:     gosumsub.ErrFileRequired,
+		},
+		{
+			name:        "missing filename",
+			applicantID: "app123",
+			metadata:    newTestIDDoc("PASSPORT", "GBR"),
+			file:        strings.NewReader("data"),
+			filename:    "",
+			wantErr:     gosumsub.ErrFilenameRequired,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := newMockClient(t, newAddIDDocumentHTTPClient())
+
+			_, err := client.AddIDDocument(
+				t.Context(), testCase.applicantID, testCase.metadata, testCase.file, testCase.filename,
+			)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("expected %v, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAddIDDocument_HTTPError(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &mockHTTPClient{
+		response: nil,
+		err:      context.DeadlineExceeded,
+	}
+
+	client := newMockClient(t, httpClient)
+
+	_, err := client.AddIDDocument(t.Context(), "app123", newTestIDDoc("PASSPORT", "GBR"), strings.NewReader("x"), "a.jpg")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestAddIDDocument_APIError(t *testing.T) {
+	t.Parallel()
+
+	body := `{"description":"Document type is not allowed","code":400,` +
+		`"correlationId":"abc123","errorCode":1003,"errorName":"BAD_REQUEST"}`
+	httpClient := &mockHTTPClient{
+		response: &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		},
+		err: nil,
+	}
+
+	client := newMockClient(t, httpClient)
+
+	_, err := client.AddIDDocument(t.Context(), "app123", newTestIDDoc("PASSPORT", "GBR"), strings.NewReader("x"), "a.jpg")
+
+	var apiErr *gosumsub.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+}
@@ -2,6 +2,18 @@ package gosumsub
 
 import "fmt"
 
+// errorName values of failures the client converts to typed errors. Matching on them, rather than on
+// the description, keeps unrelated errors that mention the same words from being misclassified.
+//
+// These values have not been confirmed against the Sumsub error reference or a recorded response;
+// until they are, treat ErrLevelNotFound, ErrShareTokenExpired and ErrShareTokenWrongClient as best
+// effort and fall back to inspecting the wrapped *APIError.
+const (
+	APIErrorNameLevelNotFound         = "LEVEL_NOT_FOUND"
+	APIErrorNameShareTokenExpired     = "SHARE_TOKEN_EXPIRED"
+	APIErrorNameShareTokenWrongClient = "SHARE_TOKEN_WRONG_CLIENT"
+)
+
 type APIError struct {
 	Description   string `json:"description"`
 	Code          int    `json:"code"`
@@ -0,0 +1,207 @@
+package gosumsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const (
+	metadataIntBase    = 10
+	metadataIntBitSize = 64
+)
+
+var (
+	ErrMetadataKeyRequired = errors.New("metadata key is required")
+	ErrMetadataKeyNotFound = errors.New("metadata key not found")
+	ErrMetadataValueType   = errors.New("metadata value has unexpected type")
+)
+
+type ApplicantMetadataItem struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type ApplicantMetadata []ApplicantMetadataItem
+
+func (m ApplicantMetadata) Get(key string) (string, bool) {
+	for _, item := range m {
+		if item.Key == key {
+			return item.Value, true
+		}
+	}
+
+	return "", false
+}
+
+func (m ApplicantMetadata) String(key string) (string, error) {
+	value, ok := m.Get(key)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrMetadataKeyNotFound, key)
+	}
+
+	return value, nil
+}
+
+func (m ApplicantMetadata) Int64(key string) (int64, error) {
+	value, err := m.String(key)
+	if err != nil {
+		return 0, err
+	}
+
+	parsed, err := strconv.ParseInt(value, metadataIntBase, metadataIntBitSize)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s: %w", ErrMetadataValueType, key, err)
+	}
+
+	return parsed, nil
+}
+
+func (m ApplicantMetadata) Bool(key string) (bool, error) {
+	value, err := m.String(key)
+	if err != nil {
+		return false, err
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s: %w", ErrMetadataValueType, key, err)
+	}
+
+	return parsed, nil
+}
+
+// With returns a copy of m where key is set to value, replacing an existing entry in place.
+func (m ApplicantMetadata) With(key, value string) ApplicantMetadata {
+	updated := make(ApplicantMetadata, 0, len(m)+1)
+	replaced := false
+
+	for _, item := range m {
+		if item.Key == key {
+			item.Value = value
+			replaced = true
+		}
+
+		updated = append(updated, item)
+	}
+
+	if !replaced {
+		updated = append(updated, ApplicantMetadataItem{Key: key, Value: value})
+	}
+
+	return updated
+}
+
+// Without returns a copy of m without key.
+func (m ApplicantMetadata) Without(key string) ApplicantMetadata {
+	updated := make(ApplicantMetadata, 0, len(m))
+
+	for _, item := range m {
+		if item.Key != key {
+			updated = append(updated, item)
+		}
+	}
+
+	return updated
+}
+
+type updateApplicantMetadataRequest struct {
+	ID       string            `json:"id"`
+	Metadata ApplicantMetadata `json:"metadata"`
+}
+
+func (c *Client) GetApplicantMetadata(ctx context.Context, applicantID string) (ApplicantMetadata, error) {
+	applicant, err := c.GetApplicantData(ctx, applicantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if applicant.Metadata == nil {
+		return ApplicantMetadata{}, nil
+	}
+
+	return applicant.Metadata, nil
+}
+
+// SetApplicantMetadata replaces the applicant's metadata with metadata.
+func (c *Client) SetApplicantMetadata(ctx context.Context, applicantID string, metadata ApplicantMetadata) error {
+	if applicantID == "" {
+		return ErrApplicantIDRequired
+	}
+
+	unlock := c.applicantLocks.lock(applicantID)
+	defer unlock()
+
+	return c.putApplicantMetadata(ctx, applicantID, metadata)
+}
+
+// SetApplicantMetadataValue sets a single key on top of the applicant's current metadata.
+func (c *Client) SetApplicantMetadataValue(ctx context.Context, applicantID, key, value string) (ApplicantMetadata, error) {
+	return c.updateApplicantMetadata(ctx, applicantID, key, func(current ApplicantMetadata) ApplicantMetadata {
+		return current.With(key, value)
+	})
+}
+
+func (c *Client) DeleteApplicantMetadataValue(ctx context.Context, applicantID, key string) (ApplicantMetadata, error) {
+	return c.updateApplicantMetadata(ctx, applicantID, key, func(current ApplicantMetadata) ApplicantMetadata {
+		return current.Without(key)
+	})
+}
+
+func (c *Client) updateApplicantMetadata(
+	ctx context.Context,
+	applicantID, key string,
+	update func(current ApplicantMetadata) ApplicantMetadata,
+) (ApplicantMetadata, error) {
+	if applicantID == "" {
+		return nil, ErrApplicantIDRequired
+	}
+
+	if key == "" {
+		return nil, ErrMetadataKeyRequired
+	}
+
+	unlock := c.applicantLocks.lock(applicantID)
+	defer unlock()
+
+	current, err := c.GetApplicantMetadata(ctx, applicantID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := update(current)
+
+	if err := c.putApplicantMetadata(ctx, applicantID, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (c *Client) putApplicantMetadata(ctx context.Context, applicantID string, metadata ApplicantMetadata) error {
+	if metadata == nil {
+		metadata = ApplicantMetadata{}
+	}
+
+	apiRequest := request{
+		Method:   http.MethodPatch,
+		Endpoint: "/resources/applicants/",
+		Params: &updateApplicantMetadataRequest{
+			ID:       applicantID,
+			Metadata: metadata,
+		},
+		Query:   nil,
+		Header:  nil,
+		Body:    nil,
+		FullURL: "",
+	}
+
+	_, err := c.execute(ctx, &apiRequest)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package gosumsub_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+type fakeMetadataStore struct {
+	mu       sync.Mutex
+	metadata gosumsub.ApplicantMetadata
+}
+
+func newMetadataHTTPClient(store *fakeMetadataStore) *recordingHTTPClient {
+	return newRecordingHTTPClient(func(req *http.Request) (*http.Response, error) {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+
+		if req.Method == http.MethodPatch {
+			var update struct {
+				ID       string                     `json:"id"`
+				Metadata gosumsub.ApplicantMetadata `json:"metadata"`
+			}
+
+			if err := json.NewDecoder(req.Body).Decode(&update); err != nil {
+				return newJSONResponse(http.StatusBadRequest, `{"code":400,"description":"bad body"}`), nil
+			}
+
+			store.metadata = update.Metadata
+		}
+
+		body, _ := json.Marshal(map[string]any{"id": "app123", "metadata": store.metadata})
+
+		return newJSONResponse(http.StatusOK, string(body)), nil
+	})
+}
+
+func TestApplicantMetadata_TypedHelpers(t *testing.T) {
+	t.Parallel()
+
+	metadata := gosumsub.ApplicantMetadata{
+		{Key: "accountNumber", Value: "100042"},
+		{Key: "isEmployee", Value: "true"},
+		{Key: "segment", Value: "retail"},
+	}
+
+	if value, ok := metadata.Get("segment"); !ok || value != "retail" {
+		t.Errorf("expected segment 'retail', got %q (found=%t)", value, ok)
+	}
+
+	accountNumber, err := metadata.Int64("accountNumber")
+	if err != nil || accountNumber != 100042 {
+		t.Errorf("expected accountNumber 100042, got %d (%v)", accountNumber, err)
+	}
+
+	isEmployee, err := metadata.Bool("isEmployee")
+	if err != nil || !isEmployee {
+		t.Errorf("expected isEmployee true, got %t (%v)", isEmployee, err)
+	}
+
+	if _, err := metadata.String("missing"); !errors.Is(err, gosumsub.ErrMetadataKeyNotFound) {
+		t.Errorf("expected ErrMetadataKeyNotFound, got %v", err)
+	}
+
+	if _, err := metadata.Int64("segment"); !errors.Is(err, gosumsub.ErrMetadataValueType) {
+		t.Errorf("expected ErrMetadataValueType, got %v", err)
+	}
+}
+
+func TestApplicantMetadata_WithAndWithout(t *testing.T) {
+	t.Parallel()
+
+	original := gosumsub.ApplicantMetadata{{Key: "segment", Value: "retail"}}
+
+	updated := original.With("segment", "private").With("accountNumber", "1")
+	if value, _ := updated.Get("segment"); value != "private" || len(updated) != 2 {
+		t.Errorf("unexpected metadata after With: %+v", updated)
+	}
+
+	if value, _ := original.Get("segment"); value != "retail" {
+		t.Errorf("expected original metadata to be unchanged, got %q", value)
+	}
+
+	removed := updated.Without("segment")
+	if _, ok := removed.Get("segment"); ok || len(removed) != 1 {
+		t.Errorf("unexpected metadata after Without: %+v", removed)
+	}
+}
+
+func TestGetApplicantMetadata_Success(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeMetadataStore{mu: sync.Mutex{}, metadata: gosumsub.ApplicantMetadata{{Key: "accountNumber", Value: "7"}}}
+	client := newMockClient(t, newMetadataHTTPClient(store))
+
+	metadata, err := client.GetApplicantMetadata(t.Context(), "app123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if value, _ := metadata.Get("accountNumber"); value != "7" {
+		t.Errorf("expected accountNumber '7', got %q", value)
+	}
+}
+
+func TestSetApplicantMetadata_Success(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeMetadataStore{mu: sync.Mutex{}, metadata: nil}
+	httpClient := newMetadataHTTPClient(store)
+	client := newMockClient(t, httpClient)
+
+	err := client.SetApplicantMetadata(t.Context(), "app123", gosumsub.ApplicantMetadata{{Key: "k", Value: "v"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recorded := httpClient.recorded()[0]
+	if recorded.method != http.MethodPatch || recorded.url != "https://api.example.com/resources/applicants/" {
+		t.Errorf("unexpected request %s %s", recorded.method, recorded.url)
+	}
+
+	if string(recorded.body) != `{"id":"app123","metadata":[{"key":"k","value":"v"}]}` {
+		t.Errorf("unexpected body %s", recorded.body)
+	}
+}
+
+func TestSetApplicantMetadataValue_MergesWithCurrent(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeMetadataStore{mu: sync.Mutex{}, metadata: gosumsub.ApplicantMetadata{{Key: "segment", Value: "retail"}}}
+	client := newMockClient(t, newMetadataHTTPClient(store))
+
+	metadata, err := client.SetApplicantMetadataValue(t.Context(), "app123", "accountNumber", "100042")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(metadata) != 2 || len(store.metadata) != 2 {
+		t.Errorf("expected 2 metadata entries, got %+v (stored %+v)", metadata, store.metadata)
+	}
+
+	metadata, err = client.DeleteApplicantMetadataValue(t.Context(), "app123", "segment")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok := metadata.Get("segment"); ok {
+		t.Errorf("expected segment to be deleted, got %+v", metadata)
+	}
+}
+
+func TestApplicantMetadata_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	if err := client.SetApplicantMetadata(t.Context(), "", nil); !errors.Is(err, gosumsub.ErrApplicantIDRequired) {
+		t.Errorf("expected ErrApplicantIDRequired, got %v", err)
+	}
+
+	if _, err := client.SetApplicantMetadataValue(t.Context(), "app123", "", "v"); !errors.Is(err, gosumsub.ErrMetadataKeyRequired) {
+		t.Errorf("expected ErrMetadataKeyRequired, got %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package gosumsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+var (
+	ErrNoteIDRequired   = errors.New("noteId is required")
+	ErrNoteTextRequired = errors.New("note is required")
+)
+
+type ApplicantNote struct {
+	ID          string   `json:"id,omitempty"`
+	ApplicantID string   `json:"applicantId,omitempty"`
+	Note        string   `json:"note,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	CreatedAt   string   `json:"createdAt,omitempty"`
+	CreatedBy   string   `json:"createdBy,omitempty"`
+	UpdatedAt   string   `json:"updatedAt,omitempty"`
+}
+
+type ApplicantNotesResponse struct {
+	Items      []ApplicantNote `json:"items,omitempty"`
+	TotalItems int             `json:"totalItems,omitempty"`
+}
+
+func (c *Client) GetApplicantNotes(ctx context.Context, applicantID string) (*ApplicantNotesResponse, error) {
+	if applicantID == "" {
+		return nil, ErrApplicantIDRequired
+	}
+
+	apiRequest := request{
+		Method:   http.MethodGet,
+		Endpoint: applicantNotesEndpoint(applicantID),
+		Params:   nil,
+		Query:    nil,
+		Header:   nil,
+		Body:     nil,
+		FullURL:  "",
+	}
+
+	body, err := c.execute(ctx, &apiRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ApplicantNotesResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) AddApplicantNote(ctx context.Context, applicantID, note string, tags ...string) (*ApplicantNote, error) {
+	if applicantID == "" {
+		return nil, ErrApplicantIDRequired
+	}
+
+	if note == "" {
+		return nil, ErrNoteTextRequired
+	}
+
+	return c.sendApplicantNote(ctx, http.MethodPost, applicantID, &ApplicantNote{
+		ID:          "",
+		ApplicantID: "",
+		Note:        note,
+		Tags:        tags,
+		CreatedAt:   "",
+		CreatedBy:   "",
+		UpdatedAt:   "",
+	})
+}
+
+func (c *Client) UpdateApplicantNote(
+	ctx context.Context,
+	applicantID, noteID, note string,
+	tags ...string,
+) (*ApplicantNote, error) {
+	if applicantID == "" {
+		return nil, ErrApplicantIDRequired
+	}
+
+	if noteID == "" {
+		return nil, ErrNoteIDRequired
+	}
+
+	if note == "" {
+		return nil, ErrNoteTextRequired
+	}
+
+	return c.sendApplicantNote(ctx, http.MethodPatch, applicantID, &ApplicantNote{
+		ID:          noteID,
+		ApplicantID: "",
+		Note:        note,
+		Tags:        tags,
+		CreatedAt:   "",
+		CreatedBy:   "",
+		UpdatedAt:   "",
+	})
+}
+
+func (c *Client) DeleteApplicantNote(ctx context.Context, applicantID, noteID string) error {
+	if applicantID == "" {
+		return ErrApplicantIDRequired
+	}
+
+	if noteID == "" {
+		return ErrNoteIDRequired
+	}
+
+	apiRequest := request{
+		Method:   http.MethodDelete,
+		Endpoint: applicantNotesEndpoint(applicantID) + "/" + url.PathEscape(noteID),
+		Params:   nil,
+		Query:    nil,
+		Header:   nil,
+		Body:     nil,
+		FullURL:  "",
+	}
+
+	_, err := c.execute(ctx, &apiRequest)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) sendApplicantNote(
+	ctx context.Context,
+	method, applicantID string,
+	note *ApplicantNote,
+) (*ApplicantNote, error) {
+	apiRequest := request{
+		Method:   method,
+		Endpoint: applicantNotesEndpoint(applicantID),
+		Params:   note,
+		Query:    nil,
+		Header:   nil,
+		Body:     nil,
+		FullURL:  "",
+	}
+
+	body, err := c.execute(ctx, &apiRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ApplicantNote
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func applicantNotesEndpoint(applicantID string) string {
+	return fmt.Sprintf("/resources/applicants/%s/notes", url.PathEscape(applicantID))
+}
@@ -0,0 +1,153 @@
+package gosumsub_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+const testApplicantNoteResponseBody = `{
+	"id": "note1",
+	"applicantId": "app123",
+	"note": "Called the customer, documents will be re-uploaded.",
+	"tags": ["manual-review"],
+	"createdAt": "2025-08-22 02:25:27"
+}`
+
+func TestGetApplicantNotes_Success(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, `{"items":[`+testApplicantNoteResponseBody+`],"totalItems":1}`), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	resp, err := client.GetApplicantNotes(t.Context(), "app123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.TotalItems != 1 || len(resp.Items) != 1 || resp.Items[0].ID != "note1" {
+		t.Errorf("unexpected notes response: %+v", resp)
+	}
+
+	recorded := httpClient.recorded()[0]
+	if recorded.method != http.MethodGet || recorded.url != "https://api.example.com/resources/applicants/app123/notes" {
+		t.Errorf("unexpected request %s %s", recorded.method, recorded.url)
+	}
+}
+
+func TestAddApplicantNote_Success(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, testApplicantNoteResponseBody), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	note, err := client.AddApplicantNote(t.Context(), "app123", "Called the customer.", "manual-review")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if note.ID != "note1" {
+		t.Errorf("expected note ID 'note1', got %q", note.ID)
+	}
+
+	recorded := httpClient.recorded()[0]
+	if recorded.method != http.MethodPost {
+		t.Errorf("expected POST, got %s", recorded.method)
+	}
+
+	if string(recorded.body) != `{"note":"Called the customer.","tags":["manual-review"]}` {
+		t.Errorf("unexpected body %s", recorded.body)
+	}
+}
+
+func TestUpdateApplicantNote_Success(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, testApplicantNoteResponseBody), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	_, err := client.UpdateApplicantNote(t.Context(), "app123", "note1", "Updated text")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recorded := httpClient.recorded()[0]
+	if recorded.method != http.MethodPatch || recorded.url != "https://api.example.com/resources/applicants/app123/notes" {
+		t.Errorf("unexpected request %s %s", recorded.method, recorded.url)
+	}
+
+	var sent gosumsub.ApplicantNote
+	if err := json.Unmarshal(recorded.body, &sent); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+
+	if sent.ID != "note1" || sent.Note != "Updated text" {
+		t.Errorf("unexpected update body %+v", sent)
+	}
+}
+
+func TestDeleteApplicantNote_Success(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, `{"ok":1}`), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	if err := client.DeleteApplicantNote(t.Context(), "app123", "note1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recorded := httpClient.recorded()[0]
+	expectedURL := "https://api.example.com/resources/applicants/app123/notes/note1"
+
+	if recorded.method != http.MethodDelete || recorded.url != expectedURL {
+		t.Errorf("expected DELETE %s, got %s %s", expectedURL, recorded.method, recorded.url)
+	}
+}
+
+func TestApplicantNotes_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	if _, err := client.GetApplicantNotes(t.Context(), ""); !errors.Is(err, gosumsub.ErrApplicantIDRequired) {
+		t.Errorf("expected ErrApplicantIDRequired, got %v", err)
+	}
+
+	if _, err := client.AddApplicantNote(t.Context(), "app123", ""); !errors.Is(err, gosumsub.ErrNoteTextRequired) {
+		t.Errorf("expected ErrNoteTextRequired, got %v", err)
+	}
+
+	if _, err := client.UpdateApplicantNote(t.Context(), "app123", "", "text"); !errors.Is(err, gosumsub.ErrNoteIDRequired) {
+		t.Errorf("expected ErrNoteIDRequired, got %v", err)
+	}
+
+	if err := client.DeleteApplicantNote(t.Context(), "app123", ""); !errors.Is(err, gosumsub.ErrNoteIDRequired) {
+		t.Errorf("expected ErrNoteIDRequired, got %v", err)
+	}
+}
+
+func TestApplicantNotes_HTTPError(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	if _, err := client.AddApplicantNote(t.Context(), "app123", "text"); !errors.Is(err, gosumsub.ErrHTTPFailure) {
+		t.Errorf("expected ErrHTTPFailure, got %v", err)
+	}
+}
@@ -0,0 +1,44 @@
+package gosumsub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	presenceDeactivated = "deactivated"
+	presenceActivated   = "activated"
+)
+
+func (c *Client) DeactivateApplicant(ctx context.Context, applicantID string) error {
+	return c.setApplicantPresence(ctx, applicantID, presenceDeactivated)
+}
+
+func (c *Client) ActivateApplicant(ctx context.Context, applicantID string) error {
+	return c.setApplicantPresence(ctx, applicantID, presenceActivated)
+}
+
+func (c *Client) setApplicantPresence(ctx context.Context, applicantID, presence string) error {
+	if applicantID == "" {
+		return ErrApplicantIDRequired
+	}
+
+	apiRequest := request{
+		Method:   http.MethodPatch,
+		Endpoint: fmt.Sprintf("/resources/applicants/%s/presence/%s", url.PathEscape(applicantID), presence),
+		Params:   nil,
+		Query:    nil,
+		Header:   nil,
+		Body:     nil,
+		FullURL:  "",
+	}
+
+	_, err := c.execute(ctx, &apiRequest)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package gosumsub_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+func TestDeactivateApplicant_Success(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, `{"ok":1}`), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	err := client.DeactivateApplicant(t.Context(), "app123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recorded := httpClient.recorded()[0]
+	expectedURL := "https://api.example.com/resources/applicants/app123/presence/deactivated"
+
+	if recorded.method != http.MethodPatch || recorded.url != expectedURL {
+		t.Errorf("expected PATCH %s, got %s %s", expectedURL, recorded.method, recorded.url)
+	}
+}
+
+func TestActivateApplicant_Success(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, `{"ok":1}`), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	err := client.ActivateApplicant(t.Context(), "app123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recorded := httpClient.recorded()[0]
+	expectedURL := "https://api.example.com/resources/applicants/app123/presence/activated"
+
+	if recorded.method != http.MethodPatch || recorded.url != expectedURL {
+		t.Errorf("expected PATCH %s, got %s %s", expectedURL, recorded.method, recorded.url)
+	}
+}
+
+func TestApplicantPresence_ApplicantIDRequired(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	if err := client.DeactivateApplicant(t.Context(), ""); !errors.Is(err, gosumsub.ErrApplicantIDRequired) {
+		t.Errorf("expected ErrApplicantIDRequired, got %v", err)
+	}
+
+	if err := client.ActivateApplicant(t.Context(), ""); !errors.Is(err, gosumsub.ErrApplicantIDRequired) {
+		t.Errorf("expected ErrApplicantIDRequired, got %v", err)
+	}
+}
+
+func TestApplicantPresence_APIError(t *testing.T) {
+	t.Parallel()
+
+	body := `{"description":"Applicant not found","code":404,` +
+		`"correlationId":"abc123","errorCode":1001,"errorName":"NOT_FOUND"}`
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusNotFound, body), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	err := client.DeactivateApplicant(t.Context(), "missing")
+
+	var apiErr *gosumsub.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+}
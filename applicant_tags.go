@@ -0,0 +1,110 @@
+package gosumsub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+)
+
+func (c *Client) GetApplicantTags(ctx context.Context, applicantID string) ([]string, error) {
+	applicant, err := c.GetApplicantData(ctx, applicantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if applicant.Tags == nil {
+		return []string{}, nil
+	}
+
+	return applicant.Tags, nil
+}
+
+// SetApplicantTags replaces the applicant's tags with tags. Passing an empty slice removes all tags.
+func (c *Client) SetApplicantTags(ctx context.Context, applicantID string, tags []string) error {
+	if applicantID == "" {
+		return ErrApplicantIDRequired
+	}
+
+	unlock := c.applicantLocks.lock(applicantID)
+	defer unlock()
+
+	return c.putApplicantTags(ctx, applicantID, tags)
+}
+
+// AddApplicantTags merges tags into the applicant's current tags and returns the resulting set.
+// Calls for the same applicant made through one Client are serialized, so concurrent adds and
+// removes are applied on top of each other instead of overwriting each other.
+func (c *Client) AddApplicantTags(ctx context.Context, applicantID string, tags ...string) ([]string, error) {
+	return c.updateApplicantTags(ctx, applicantID, func(current []string) []string {
+		for _, tag := range tags {
+			if !slices.Contains(current, tag) {
+				current = append(current, tag)
+			}
+		}
+
+		return current
+	})
+}
+
+// RemoveApplicantTags removes tags from the applicant's current tags and returns the resulting set.
+func (c *Client) RemoveApplicantTags(ctx context.Context, applicantID string, tags ...string) ([]string, error) {
+	return c.updateApplicantTags(ctx, applicantID, func(current []string) []string {
+		return slices.DeleteFunc(current, func(tag string) bool {
+			return slices.Contains(tags, tag)
+		})
+	})
+}
+
+func (c *Client) updateApplicantTags(
+	ctx context.Context,
+	applicantID string,
+	update func(current []string) []string,
+) ([]string, error) {
+	if applicantID == "" {
+		return nil, ErrApplicantIDRequired
+	}
+
+	unlock := c.applicantLocks.lock(applicantID)
+	defer unlock()
+
+	current, err := c.GetApplicantTags(ctx, applicantID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := update(slices.Clone(current))
+	if slices.Equal(current, updated) {
+		return updated, nil
+	}
+
+	if err := c.putApplicantTags(ctx, applicantID, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (c *Client) putApplicantTags(ctx context.Context, applicantID string, tags []string) error {
+	if tags == nil {
+		tags = []string{}
+	}
+
+	apiRequest := request{
+		Method:   http.MethodPost,
+		Endpoint: fmt.Sprintf("/resources/applicants/%s/tags", url.PathEscape(applicantID)),
+		Params:   tags,
+		Query:    nil,
+		Header:   nil,
+		Body:     nil,
+		FullURL:  "",
+	}
+
+	_, err := c.execute(ctx, &apiRequest)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
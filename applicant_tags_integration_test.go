@@ -0,0 +1,29 @@
+package gosumsub_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestIntegration_GetApplicantTags(t *testing.T) {
+	t.Parallel()
+
+	applicantID := strings.TrimSpace(os.Getenv("SUMSUB_TEST_APPLICANT_ID"))
+	if applicantID == "" {
+		t.Skip("skipping test: SUMSUB_TEST_APPLICANT_ID not set")
+	}
+
+	client := newTestClient(t)
+
+	tags, err := client.GetApplicantTags(t.Context(), applicantID)
+	if err != nil {
+		t.Fatalf("GetApplicantTags failed: %v", err)
+	}
+
+	if tags == nil {
+		t.Error("expected non-nil tags")
+	}
+
+	t.Logf("Tags: %v", tags)
+}
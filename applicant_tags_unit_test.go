@@ -0,0 +1,224 @@
+package gosumsub_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+type fakeTagStore struct {
+	mu     sync.Mutex
+	tags   []string
+	writes int
+}
+
+func (s *fakeTagStore) snapshot() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.tags)
+}
+
+func newTagsHTTPClient(store *fakeTagStore) *recordingHTTPClient {
+	return newRecordingHTTPClient(func(req *http.Request) (*http.Response, error) {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+
+		if req.Method == http.MethodPost {
+			var tags []string
+			if err := json.NewDecoder(req.Body).Decode(&tags); err != nil {
+				return newJSONResponse(http.StatusBadRequest, `{"code":400,"description":"bad tags"}`), nil
+			}
+
+			store.tags = tags
+			store.writes++
+
+			return newJSONResponse(http.StatusOK, `{"ok":1}`), nil
+		}
+
+		body, _ := json.Marshal(map[string]any{"id": "app123", "tags": store.tags})
+
+		return newJSONResponse(http.StatusOK, string(body)), nil
+	})
+}
+
+func TestGetApplicantTags_Success(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeTagStore{mu: sync.Mutex{}, tags: []string{"vip"}, writes: 0}
+	client := newMockClient(t, newTagsHTTPClient(store))
+
+	tags, err := client.GetApplicantTags(t.Context(), "app123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !slices.Equal(tags, []string{"vip"}) {
+		t.Errorf("expected [vip], got %v", tags)
+	}
+}
+
+func TestGetApplicantTags_NoTags(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeTagStore{mu: sync.Mutex{}, tags: nil, writes: 0}
+	client := newMockClient(t, newTagsHTTPClient(store))
+
+	tags, err := client.GetApplicantTags(t.Context(), "app123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if tags == nil || len(tags) != 0 {
+		t.Errorf("expected empty non-nil tags, got %v", tags)
+	}
+}
+
+func TestSetApplicantTags_Success(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeTagStore{mu: sync.Mutex{}, tags: []string{"vip"}, writes: 0}
+	httpClient := newTagsHTTPClient(store)
+	client := newMockClient(t, httpClient)
+
+	err := client.SetApplicantTags(t.Context(), "app123", []string{"manual-review"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recorded := httpClient.recorded()[0]
+	if recorded.method != http.MethodPost || recorded.url != "https://api.example.com/resources/applicants/app123/tags" {
+		t.Errorf("unexpected request %s %s", recorded.method, recorded.url)
+	}
+
+	if string(recorded.body) != `["manual-review"]` {
+		t.Errorf("unexpected body %s", recorded.body)
+	}
+}
+
+func TestSetApplicantTags_ClearWithNil(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeTagStore{mu: sync.Mutex{}, tags: []string{"vip"}, writes: 0}
+	httpClient := newTagsHTTPClient(store)
+	client := newMockClient(t, httpClient)
+
+	if err := client.SetApplicantTags(t.Context(), "app123", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body := string(httpClient.recorded()[0].body); body != "[]" {
+		t.Errorf("expected empty JSON array, got %s", body)
+	}
+}
+
+func TestAddApplicantTags_Merges(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeTagStore{mu: sync.Mutex{}, tags: []string{"vip"}, writes: 0}
+	client := newMockClient(t, newTagsHTTPClient(store))
+
+	tags, err := client.AddApplicantTags(t.Context(), "app123", "vip", "sanctions-hit")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"vip", "sanctions-hit"}
+	if !slices.Equal(tags, expected) || !slices.Equal(store.snapshot(), expected) {
+		t.Errorf("expected %v, got %v (stored %v)", expected, tags, store.snapshot())
+	}
+}
+
+func TestAddApplicantTags_NoChangeSkipsWrite(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeTagStore{mu: sync.Mutex{}, tags: []string{"vip"}, writes: 0}
+	client := newMockClient(t, newTagsHTTPClient(store))
+
+	if _, err := client.AddApplicantTags(t.Context(), "app123", "vip"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if store.writes != 0 {
+		t.Errorf("expected no write when tags are unchanged, got %d", store.writes)
+	}
+}
+
+func TestRemoveApplicantTags_Success(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeTagStore{mu: sync.Mutex{}, tags: []string{"vip", "manual-review", "sanctions-hit"}, writes: 0}
+	client := newMockClient(t, newTagsHTTPClient(store))
+
+	tags, err := client.RemoveApplicantTags(t.Context(), "app123", "manual-review", "unknown")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"vip", "sanctions-hit"}
+	if !slices.Equal(tags, expected) || !slices.Equal(store.snapshot(), expected) {
+		t.Errorf("expected %v, got %v (stored %v)", expected, tags, store.snapshot())
+	}
+}
+
+func TestApplicantTags_ConcurrentUpdatesMerge(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeTagStore{mu: sync.Mutex{}, tags: []string{"to-remove"}, writes: 0}
+	client := newMockClient(t, newTagsHTTPClient(store))
+
+	added := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+
+	var waitGroup sync.WaitGroup
+
+	for _, tag := range added {
+		waitGroup.Go(func() {
+			if _, err := client.AddApplicantTags(context.Background(), "app123", tag); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	waitGroup.Go(func() {
+		if _, err := client.RemoveApplicantTags(context.Background(), "app123", "to-remove"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	waitGroup.Wait()
+
+	final := store.snapshot()
+	slices.Sort(final)
+
+	if !slices.Equal(final, added) {
+		t.Errorf("expected %v after concurrent updates, got %v", added, final)
+	}
+}
+
+func TestApplicantTags_ApplicantIDRequired(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	if err := client.SetApplicantTags(t.Context(), "", nil); !errors.Is(err, gosumsub.ErrApplicantIDRequired) {
+		t.Errorf("expected ErrApplicantIDRequired, got %v", err)
+	}
+
+	if _, err := client.AddApplicantTags(t.Context(), "", "vip"); !errors.Is(err, gosumsub.ErrApplicantIDRequired) {
+		t.Errorf("expected ErrApplicantIDRequired, got %v", err)
+	}
+
+	if _, err := client.RemoveApplicantTags(t.Context(), "", "vip"); !errors.Is(err, gosumsub.ErrApplicantIDRequired) {
+		t.Errorf("expected ErrApplicantIDRequired, got %v", err)
+	}
+
+	if _, err := client.GetApplicantTags(t.Context(), ""); !errors.Is(err, gosumsub.ErrApplicantIDRequired) {
+		t.Errorf("expected ErrApplicantIDRequired, got %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package gosumsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+var (
+	ErrLevelNotFound       = errors.New("level not found")
+	ErrLinkRequestRequired = errors.New("web SDK link request is required")
+)
+
+func (c *Client) ChangeApplicantLevel(ctx context.Context, applicantID, levelName string) (*ApplicantData, error) {
+	if applicantID == "" {
+		return nil, ErrApplicantIDRequired
+	}
+
+	if levelName == "" {
+		return nil, ErrLevelNameRequired
+	}
+
+	apiRequest := request{
+		Method:   http.MethodPost,
+		Endpoint: fmt.Sprintf("/resources/applicants/%s/moveToLevel", url.PathEscape(applicantID)),
+		Params:   nil,
+		Query:    url.Values{"name": []string{levelName}},
+		Header:   nil,
+		Body:     nil,
+		FullURL:  "",
+	}
+
+	body, err := c.execute(ctx, &apiRequest)
+	if err != nil {
+		return nil, mapLevelError(err)
+	}
+
+	var resp ApplicantData
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ChangeApplicantLevelWithLink moves the applicant to req.LevelName and then generates a Web SDK
+// link for that level. When req.UserID is empty the applicant's externalUserId is used, so the
+// link resumes the same applicant instead of creating a new one.
+func (c *Client) ChangeApplicantLevelWithLink(
+	ctx context.Context,
+	applicantID string,
+	req *GenerateExternalWebSDKLinkRequest,
+) (*GenerateExternalWebSDKLinkResponse, error) {
+	if req == nil {
+		return nil, ErrLinkRequestRequired
+	}
+
+	applicant, err := c.ChangeApplicantLevel(ctx, applicantID, req.LevelName)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.UserID == "" {
+		req.UserID = applicant.ExternalUserID
+	}
+
+	resp, err := c.GenerateExternalWebSDKLink(ctx, req)
+	if err != nil {
+		return nil, mapLevelError(err)
+	}
+
+	return resp, nil
+}
+
+func mapLevelError(err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	if apiErr.ErrorName == APIErrorNameLevelNotFound {
+		return fmt.Errorf("%w: %w", ErrLevelNotFound, err)
+	}
+
+	return err
+}
@@ -0,0 +1,234 @@
+package gosumsub_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andyle182810/gosumsub"
+	"github.com/andyle182810/gosumsub/signer"
+)
+
+const testMovedApplicantResponseBody = `{
+	"id": "68a7d46b8a6f58bf219053c6",
+	"externalUserId": "28",
+	"review": {
+		"levelName": "enhanced-kyc-level",
+		"reviewStatus": "init"
+	}
+}`
+
+func TestChangeApplicantLevel_Success(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, testMovedApplicantResponseBody), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	resp, err := client.ChangeApplicantLevel(t.Context(), "68a7d46b8a6f58bf219053c6", "enhanced-kyc-level")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.Review == nil || resp.Review.LevelName != "enhanced-kyc-level" {
+		t.Errorf("expected applicant on level 'enhanced-kyc-level', got %+v", resp.Review)
+	}
+
+	recorded := httpClient.recorded()[0]
+	expectedURL := "https://api.example.com/resources/applicants/68a7d46b8a6f58bf219053c6/moveToLevel?name=enhanced-kyc-level"
+
+	if recorded.method != http.MethodPost || recorded.url != expectedURL {
+		t.Errorf("expected POST %s, got %s %s", expectedURL, recorded.method, recorded.url)
+	}
+}
+
+func TestChangeApplicantLevel_SignsQuery(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1234567890, 0)
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, testMovedApplicantResponseBody), nil
+	})
+
+	client, err := gosumsub.NewClient(
+		"https://api.example.com",
+		"test-token",
+		"test-secret",
+		gosumsub.WithHTTPClient(httpClient),
+		gosumsub.WithClock(func() time.Time { return now }),
+	)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if _, err := client.ChangeApplicantLevel(t.Context(), "app123", "enhanced kyc"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recorded := httpClient.recorded()[0]
+
+	requestSigner, err := signer.NewSigner("test-secret")
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+
+	uri := "/resources/applicants/app123/moveToLevel?name=enhanced+kyc"
+
+	expected, err := requestSigner.Sign(now, http.MethodPost, uri, nil)
+	if err != nil {
+		t.Fatalf("failed to sign: %v", err)
+	}
+
+	if recorded.url != "https://api.example.com"+uri {
+		t.Errorf("expected URL with query %q, got %q", uri, recorded.url)
+	}
+
+	if got := recorded.header.Get("X-App-Access-Sig"); got != expected {
+		t.Errorf("expected signature %q over path and query, got %q", expected, got)
+	}
+}
+
+func TestChangeApplicantLevel_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	_, err := client.ChangeApplicantLevel(t.Context(), "", "enhanced-kyc-level")
+	if !errors.Is(err, gosumsub.ErrApplicantIDRequired) {
+		t.Errorf("expected ErrApplicantIDRequired, got %v", err)
+	}
+
+	_, err = client.ChangeApplicantLevel(t.Context(), "app123", "")
+	if !errors.Is(err, gosumsub.ErrLevelNameRequired) {
+		t.Errorf("expected ErrLevelNameRequired, got %v", err)
+	}
+}
+
+func TestChangeApplicantLevel_UnknownLevel(t *testing.T) {
+	t.Parallel()
+
+	// Synthetic body, not a recorded Sumsub response; see APIErrorNameLevelNotFound.
+	body := `{"description":"Level 'no-such-level' not found","code":404,` +
+		`"correlationId":"abc123","errorName":"` + gosumsub.APIErrorNameLevelNotFound + `"}`
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusNotFound, body), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	_, err := client.ChangeApplicantLevel(t.Context(), "app123", "no-such-level")
+	if !errors.Is(err, gosumsub.ErrLevelNotFound) {
+		t.Fatalf("expected ErrLevelNotFound, got %v", err)
+	}
+
+	var apiErr *gosumsub.APIError
+	if !errors.As(err, &apiErr) || apiErr.CorrelationID != "abc123" {
+		t.Errorf("expected wrapped APIError with correlation ID, got %v", err)
+	}
+}
+
+func TestChangeApplicantLevel_ApplicantNotFound(t *testing.T) {
+	t.Parallel()
+
+	body := `{"description":"Applicant not found","code":404,` +
+		`"correlationId":"abc123","errorCode":1001,"errorName":"NOT_FOUND"}`
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusNotFound, body), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	_, err := client.ChangeApplicantLevel(t.Context(), "missing", "enhanced-kyc-level")
+	if errors.Is(err, gosumsub.ErrLevelNotFound) {
+		t.Fatal("did not expect ErrLevelNotFound for a missing applicant")
+	}
+
+	var apiErr *gosumsub.APIError
+	if !errors.As(err, &apiErr) {
+		t.Errorf("expected APIError, got %v", err)
+	}
+}
+
+func TestChangeApplicantLevel_OtherErrorMentioningLevel(t *testing.T) {
+	t.Parallel()
+
+	body := `{"description":"Applicant is already on level 'enhanced-kyc-level'","code":400,` +
+		`"correlationId":"abc123","errorCode":1003,"errorName":"BAD_REQUEST"}`
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusBadRequest, body), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	_, err := client.ChangeApplicantLevel(t.Context(), "app123", "enhanced-kyc-level")
+	if errors.Is(err, gosumsub.ErrLevelNotFound) {
+		t.Fatal("did not expect ErrLevelNotFound for an error that only mentions a level")
+	}
+
+	var apiErr *gosumsub.APIError
+	if !errors.As(err, &apiErr) {
+		t.Errorf("expected APIError, got %v", err)
+	}
+}
+
+func TestChangeApplicantLevelWithLink_Success(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if strings.HasSuffix(req.URL.Path, "/moveToLevel") {
+			return newJSONResponse(http.StatusOK, testMovedApplicantResponseBody), nil
+		}
+
+		return newJSONResponse(http.StatusOK, testSDKLinkResponseBody), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	req := &gosumsub.GenerateExternalWebSDKLinkRequest{
+		TTLInSecs:            0,
+		UserID:               "",
+		LevelName:            "enhanced-kyc-level",
+		ApplicantIdentifiers: nil,
+		Redirect:             nil,
+	}
+
+	resp, err := client.ChangeApplicantLevelWithLink(t.Context(), "68a7d46b8a6f58bf219053c6", req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.URL != "https://in.sumsub.com/websdk/abc123" {
+		t.Errorf("unexpected URL %q", resp.URL)
+	}
+
+	requests := httpClient.recorded()
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+
+	var linkRequest gosumsub.GenerateExternalWebSDKLinkRequest
+	if err := json.Unmarshal(requests[1].body, &linkRequest); err != nil {
+		t.Fatalf("failed to decode link request: %v", err)
+	}
+
+	if linkRequest.UserID != "28" || linkRequest.LevelName != "enhanced-kyc-level" {
+		t.Errorf("expected link for user '28' on 'enhanced-kyc-level', got %+v", linkRequest)
+	}
+}
+
+func TestChangeApplicantLevelWithLink_NilRequest(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	_, err := client.ChangeApplicantLevelWithLink(t.Context(), "app123", nil)
+	if !errors.Is(err, gosumsub.ErrLinkRequestRequired) {
+		t.Errorf("expected ErrLinkRequestRequired, got %v", err)
+	}
+}
@@ -53,13 +53,14 @@ var (
 type Option func(*Client)
 
 type Client struct {
-	baseURL    string
-	logger     Logger
-	debug      bool
-	token      string
-	signer     Signer
-	clock      ClockFunc
-	httpClient HTTPClient
+	baseURL        string
+	logger         Logger
+	debug          bool
+	token          string
+	signer         Signer
+	clock          ClockFunc
+	httpClient     HTTPClient
+	applicantLocks *keyedMutex
 }
 
 func NewClient(baseURL, token, secret string, opts ...Option) (*Client, error) {
@@ -84,13 +85,14 @@ func NewClient(baseURL, token, secret string, opts ...Option) (*Client, error) {
 	}
 
 	client := &Client{
-		baseURL:    baseURL,
-		logger:     slog.Default(),
-		debug:      false,
-		token:      token,
-		signer:     signer,
-		clock:      time.Now,
-		httpClient: http.DefaultClient,
+		baseURL:        baseURL,
+		logger:         slog.Default(),
+		debug:          false,
+		token:          token,
+		signer:         signer,
+		clock:          time.Now,
+		httpClient:     http.DefaultClient,
+		applicantLocks: newKeyedMutex(),
 	}
 
 	for _, opt := range opts {
@@ -149,21 +151,26 @@ func (c *Client) buildRequest(req *request) error {
 		return ErrNilRequest
 	}
 
-	fullURL := c.baseURL + req.Endpoint
+	// The signature covers the path with its query string, so both are built from the same URI.
+	uri := req.Endpoint
+	if len(req.Query) > 0 {
+		uri += "?" + req.Query.Encode()
+	}
+
+	fullURL := c.baseURL + uri
 
 	headers := http.Header{}
 	if req.Header != nil {
 		headers = req.Header.Clone()
 	}
 
-	headers.Set("Content-Type", "application/json")
+	if headers.Get("Content-Type") == "" {
+		headers.Set("Content-Type", "application/json")
+	}
+
 	headers.Set("User-Agent", UserAgent)
 	headers.Set("X-App-Token", c.token)
 
-	if len(req.Query) > 0 {
-		fullURL += "?" + req.Query.Encode()
-	}
-
 	now := c.clock()
 	headers.Set("X-App-Access-Ts", strconv.FormatInt(now.Unix(), 10))
 
@@ -177,9 +184,17 @@ func (c *Client) buildRequest(req *request) error {
 
 		bodyBytes = &encodedBody
 		req.Body = bytes.NewReader(encodedBody)
+	} else if req.Body != nil {
+		rawBody, err := io.ReadAll(req.Body)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrRequestEncode, err)
+		}
+
+		bodyBytes = &rawBody
+		req.Body = bytes.NewReader(rawBody)
 	}
 
-	sig, err := c.signer.Sign(now, req.Method, req.Endpoint, bodyBytes)
+	sig, err := c.signer.Sign(now, req.Method, uri, bodyBytes)
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrRequestSign, err)
 	}
@@ -188,7 +203,7 @@ func (c *Client) buildRequest(req *request) error {
 
 	c.logDebug("http request", "url", fullURL)
 
-	if bodyBytes != nil {
+	if bodyBytes != nil && req.Params != nil {
 		c.logDebug("http request body", "body", string(*bodyBytes))
 	}
 
@@ -216,10 +231,39 @@ func (c *Client) execute(ctx context.Context, req *request) ([]byte, error) {
 }
 
 func (c *Client) executeWithContentType(ctx context.Context, req *request) ([]byte, string, error) {
-	if err := c.buildRequest(req); err != nil {
+	body, header, err := c.executeWithHeader(ctx, req)
+	if err != nil {
 		return nil, "", err
 	}
 
+	return body, header.Get("Content-Type"), nil
+}
+
+func (c *Client) executeWithHeader(ctx context.Context, req *request) ([]byte, http.Header, error) {
+	resp, err := c.executeStream(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer resp.Body.Close()
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.logDebug("http response body", "body", string(responseBody))
+
+	return responseBody, resp.Header, nil
+}
+
+// executeStream sends the request and returns the response with its body still open. Non-2xx
+// responses are consumed and converted to errors. The caller must close the returned body.
+func (c *Client) executeStream(ctx context.Context, req *request) (*http.Response, error) {
+	if err := c.buildRequest(req); err != nil {
+		return nil, err
+	}
+
 	httpReq, err := http.NewRequestWithContext(
 		ctx,
 		req.Method,
@@ -227,29 +271,30 @@ func (c *Client) executeWithContentType(ctx context.Context, req *request) ([]by
 		req.Body,
 	)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	httpReq.Header = req.Header
 
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		return nil, "", fmt.Errorf("%w: %w", ErrHTTPFailure, err)
-	}
-
-	defer resp.Body.Close()
-
-	responseBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, "", err
+		return nil, fmt.Errorf("%w: %w", ErrHTTPFailure, err)
 	}
 
 	c.logDebug("http response status", "status", resp.StatusCode)
-	c.logDebug("http response body", "body", string(responseBody))
 
 	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
-		return nil, "", c.handleErrorResponse(resp.StatusCode, responseBody)
+		defer resp.Body.Close()
+
+		responseBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		c.logDebug("http response body", "body", string(responseBody))
+
+		return nil, c.handleErrorResponse(resp.StatusCode, responseBody)
 	}
 
-	return responseBody, resp.Header.Get("Content-Type"), nil
+	return resp, nil
 }
@@ -0,0 +1,257 @@
+package gosumsub
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"mime"
+	"strings"
+	"sync"
+)
+
+const (
+	defaultImageDownloadConcurrency = 4
+	ImageManifestName               = "manifest.json"
+)
+
+var (
+	ErrImageSinkRequired    = errors.New("image sink is required")
+	ErrInspectionIDNotFound = errors.New("applicant has no inspectionId")
+)
+
+// ApplicantImage is a single downloaded image together with the metadata Sumsub holds for it.
+type ApplicantImage struct {
+	Item  DocumentImageItem
+	Image *GetDocumentImageResponse
+}
+
+type ImageManifestEntry struct {
+	ImageID      string        `json:"imageId"`
+	Name         string        `json:"name"`
+	MimeType     string        `json:"mimeType"`
+	Size         int           `json:"size"`
+	SHA256       string        `json:"sha256"`
+	AddedDate    string        `json:"addedDate,omitempty"`
+	IDDocDef     *IDDocDef     `json:"idDocDef,omitempty"`
+	FileMetadata *FileMetadata `json:"fileMetadata,omitempty"`
+}
+
+type ImageManifest struct {
+	ApplicantID  string               `json:"applicantId"`
+	InspectionID string               `json:"inspectionId"`
+	Images       []ImageManifestEntry `json:"images"`
+}
+
+type DownloadImagesOption func(*downloadImagesOptions)
+
+type downloadImagesOptions struct {
+	concurrency int
+}
+
+// WithImageDownloadConcurrency limits how many images are fetched at once. The default is 4.
+func WithImageDownloadConcurrency(concurrency int) DownloadImagesOption {
+	return func(o *downloadImagesOptions) {
+		if concurrency > 0 {
+			o.concurrency = concurrency
+		}
+	}
+}
+
+// ApplicantImages iterates over the applicant's active document images, downloading them one at a
+// time as the loop advances. Iteration stops after the first error, which is yielded with a nil image.
+func (c *Client) ApplicantImages(ctx context.Context, applicantID string) iter.Seq2[*ApplicantImage, error] {
+	return func(yield func(*ApplicantImage, error) bool) {
+		inspectionID, items, err := c.resolveApplicantImages(ctx, applicantID)
+		if err != nil {
+			yield(nil, err)
+
+			return
+		}
+
+		for _, item := range items {
+			image, err := c.GetDocumentImage(ctx, inspectionID, item.ID)
+			if err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			if !yield(&ApplicantImage{Item: item, Image: image}, nil) {
+				return
+			}
+		}
+	}
+}
+
+// DownloadAllApplicantImages fetches every active document image of the applicant and writes it to
+// sink, followed by an ImageManifestName entry describing the images. Images are stored as
+// "<imageId><ext>". On error, images written before the failure are left in the sink.
+func (c *Client) DownloadAllApplicantImages(
+	ctx context.Context,
+	applicantID string,
+	sink ImageSink,
+	opts ...DownloadImagesOption,
+) (*ImageManifest, error) {
+	if sink == nil {
+		return nil, ErrImageSinkRequired
+	}
+
+	options := downloadImagesOptions{concurrency: defaultImageDownloadConcurrency}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	inspectionID, items, err := c.resolveApplicantImages(ctx, applicantID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := c.downloadImages(ctx, inspectionID, items, sink, options.concurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &ImageManifest{
+		ApplicantID:  applicantID,
+		InspectionID: inspectionID,
+		Images:       entries,
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sink.Put(ctx, ImageManifestName, data); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func (c *Client) downloadImages(
+	ctx context.Context,
+	inspectionID string,
+	items []DocumentImageItem,
+	sink ImageSink,
+	concurrency int,
+) ([]ImageManifestEntry, error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	entries := make([]ImageManifestEntry, len(items))
+	slots := make(chan struct{}, concurrency)
+
+	var waitGroup sync.WaitGroup
+
+	for i, item := range items {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		waitGroup.Go(func() {
+			defer func() { <-slots }()
+
+			entry, err := c.downloadImage(ctx, inspectionID, item, sink)
+			if err != nil {
+				cancel(fmt.Errorf("image %s: %w", item.ID, err))
+
+				return
+			}
+
+			entries[i] = *entry
+		})
+	}
+
+	waitGroup.Wait()
+
+	if err := context.Cause(ctx); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (c *Client) downloadImage(
+	ctx context.Context,
+	inspectionID string,
+	item DocumentImageItem,
+	sink ImageSink,
+) (*ImageManifestEntry, error) {
+	image, err := c.GetDocumentImage(ctx, inspectionID, item.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := item.ID + imageExtension(image.MimeType)
+
+	if err := sink.Put(ctx, name, image.Data); err != nil {
+		return nil, err
+	}
+
+	checksum := sha256.Sum256(image.Data)
+
+	return &ImageManifestEntry{
+		ImageID:      item.ID,
+		Name:         name,
+		MimeType:     image.MimeType,
+		Size:         len(image.Data),
+		SHA256:       hex.EncodeToString(checksum[:]),
+		AddedDate:    item.AddedDate,
+		IDDocDef:     item.IDDocDef,
+		FileMetadata: item.FileMetadata,
+	}, nil
+}
+
+func (c *Client) resolveApplicantImages(
+	ctx context.Context,
+	applicantID string,
+) (string, []DocumentImageItem, error) {
+	applicant, err := c.GetApplicantData(ctx, applicantID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if applicant.InspectionID == "" {
+		return "", nil, ErrInspectionIDNotFound
+	}
+
+	images, err := c.GetInformationDocumentImages(ctx, applicantID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return applicant.InspectionID, images.ActiveItems(), nil
+}
+
+func imageExtension(mimeType string) string {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return ".bin"
+	}
+
+	switch mediaType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "application/pdf":
+		return ".pdf"
+	}
+
+	extensions, err := mime.ExtensionsByType(mediaType)
+	if err != nil || len(extensions) == 0 {
+		return ".bin"
+	}
+
+	return strings.ToLower(extensions[0])
+}
@@ -0,0 +1,32 @@
+package gosumsub_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+func TestIntegration_DownloadAllApplicantImages(t *testing.T) {
+	t.Parallel()
+
+	applicantID := strings.TrimSpace(os.Getenv("SUMSUB_TEST_APPLICANT_ID"))
+	if applicantID == "" {
+		t.Skip("skipping test: SUMSUB_TEST_APPLICANT_ID not set")
+	}
+
+	client := newTestClient(t)
+	sink := gosumsub.NewMemoryImageSink()
+
+	manifest, err := client.DownloadAllApplicantImages(t.Context(), applicantID, sink)
+	if err != nil {
+		t.Fatalf("DownloadAllApplicantImages failed: %v", err)
+	}
+
+	if len(sink.Files()) != len(manifest.Images)+1 {
+		t.Errorf("expected %d files in sink, got %d", len(manifest.Images)+1, len(sink.Files()))
+	}
+
+	t.Logf("Downloaded %d images for inspection %s", len(manifest.Images), manifest.InspectionID)
+}
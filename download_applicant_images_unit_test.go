@@ -0,0 +1,259 @@
+package gosumsub_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+const testImagesMetadataBody = `{"items":[
+	{"id":"img1","idDocDef":{"country":"VNM","idDocType":"PASSPORT"},"fileMetadata":{"fileName":"passport.jpg"}},
+	{"id":"img2","deactivated":true},
+	{"id":"img3","idDocDef":{"country":"VNM","idDocType":"SELFIE"}}
+],"totalItems":3}`
+
+func newImagesHTTPClient(failImage string, inFlight, maxInFlight *atomic.Int32) *recordingHTTPClient {
+	return newRecordingHTTPClient(func(req *http.Request) (*http.Response, error) {
+		path := req.URL.Path
+
+		switch {
+		case strings.HasSuffix(path, "/one"):
+			return newJSONResponse(http.StatusOK, `{"id":"app123","inspectionId":"insp456"}`), nil
+		case strings.HasSuffix(path, "/metadata/resources"):
+			return newJSONResponse(http.StatusOK, testImagesMetadataBody), nil
+		}
+
+		imageID := path[strings.LastIndex(path, "/")+1:]
+
+		if inFlight != nil {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+
+			for {
+				seen := maxInFlight.Load()
+				if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+		}
+
+		if imageID == failImage {
+			return newJSONResponse(http.StatusNotFound, `{"code":404,"description":"not found"}`), nil
+		}
+
+		header := http.Header{}
+		header.Set("Content-Type", "image/png")
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader("data-" + imageID)),
+		}, nil
+	})
+}
+
+func sha256Hex(data string) string {
+	sum := sha256.Sum256([]byte(data))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func TestDownloadAllApplicantImages_MemorySink(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, newImagesHTTPClient("", nil, nil))
+	sink := gosumsub.NewMemoryImageSink()
+
+	manifest, err := client.DownloadAllApplicantImages(t.Context(), "app123", sink)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if manifest.InspectionID != "insp456" || len(manifest.Images) != 2 {
+		t.Fatalf("unexpected manifest %+v", manifest)
+	}
+
+	first := manifest.Images[0]
+	if first.ImageID != "img1" || first.Name != "img1.png" || first.SHA256 != sha256Hex("data-img1") {
+		t.Errorf("unexpected first entry %+v", first)
+	}
+
+	if first.IDDocDef == nil || first.IDDocDef.IDDocType != "PASSPORT" {
+		t.Errorf("expected IDDocDef to be copied, got %+v", first.IDDocDef)
+	}
+
+	if first.FileMetadata == nil || first.FileMetadata.FileName != "passport.jpg" {
+		t.Errorf("expected FileMetadata to be copied, got %+v", first.FileMetadata)
+	}
+
+	files := sink.Files()
+	if string(files["img3.png"]) != "data-img3" {
+		t.Errorf("unexpected img3 content %q", files["img3.png"])
+	}
+
+	if _, ok := files["img2.png"]; ok {
+		t.Error("expected deactivated image to be skipped")
+	}
+
+	var stored gosumsub.ImageManifest
+	if err := json.Unmarshal(files[gosumsub.ImageManifestName], &stored); err != nil {
+		t.Fatalf("failed to decode manifest: %v", err)
+	}
+
+	if len(stored.Images) != 2 || stored.ApplicantID != "app123" {
+		t.Errorf("unexpected stored manifest %+v", stored)
+	}
+}
+
+func TestDownloadAllApplicantImages_DirectorySink(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, newImagesHTTPClient("", nil, nil))
+	dir := filepath.Join(t.TempDir(), "images")
+
+	if _, err := client.DownloadAllApplicantImages(t.Context(), "app123", gosumsub.NewDirectoryImageSink(dir)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, name := range []string{"img1.png", "img3.png", gosumsub.ImageManifestName} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("expected %s to exist: %v", name, err)
+		}
+	}
+}
+
+func TestDownloadAllApplicantImages_ZipSink(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, newImagesHTTPClient("", nil, nil))
+
+	var buf bytes.Buffer
+
+	sink := gosumsub.NewZipImageSink(&buf)
+
+	if _, err := client.DownloadAllApplicantImages(t.Context(), "app123", sink); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := sink.Close(); err != nil {
+		t.Fatalf("failed to close zip: %v", err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("failed to read zip: %v", err)
+	}
+
+	if len(archive.File) != 3 {
+		t.Errorf("expected 3 files in archive, got %d", len(archive.File))
+	}
+}
+
+func TestDownloadAllApplicantImages_BoundedConcurrency(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, maxInFlight atomic.Int32
+
+	client := newMockClient(t, newImagesHTTPClient("", &inFlight, &maxInFlight))
+
+	_, err := client.DownloadAllApplicantImages(
+		t.Context(),
+		"app123",
+		gosumsub.NewMemoryImageSink(),
+		gosumsub.WithImageDownloadConcurrency(1),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := maxInFlight.Load(); got != 1 {
+		t.Errorf("expected at most 1 concurrent download, got %d", got)
+	}
+}
+
+func TestDownloadAllApplicantImages_ImageError(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, newImagesHTTPClient("img3", nil, nil))
+	sink := gosumsub.NewMemoryImageSink()
+
+	_, err := client.DownloadAllApplicantImages(t.Context(), "app123", sink)
+
+	var apiErr *gosumsub.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 APIError, got %v", err)
+	}
+
+	if _, ok := sink.Files()[gosumsub.ImageManifestName]; ok {
+		t.Error("expected no manifest after a failed download")
+	}
+}
+
+func TestDownloadAllApplicantImages_NoInspection(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{
+		response: newJSONResponse(http.StatusOK, `{"id":"app123"}`),
+		err:      nil,
+	})
+
+	_, err := client.DownloadAllApplicantImages(t.Context(), "app123", gosumsub.NewMemoryImageSink())
+	if !errors.Is(err, gosumsub.ErrInspectionIDNotFound) {
+		t.Errorf("expected ErrInspectionIDNotFound, got %v", err)
+	}
+}
+
+func TestDownloadAllApplicantImages_NilSink(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	_, err := client.DownloadAllApplicantImages(t.Context(), "app123", nil)
+	if !errors.Is(err, gosumsub.ErrImageSinkRequired) {
+		t.Errorf("expected ErrImageSinkRequired, got %v", err)
+	}
+}
+
+func TestApplicantImages_Iterates(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newImagesHTTPClient("", nil, nil)
+	client := newMockClient(t, httpClient)
+
+	var ids []string
+
+	for image, err := range client.ApplicantImages(t.Context(), "app123") {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if string(image.Image.Data) != "data-"+image.Item.ID {
+			t.Errorf("unexpected data for %s: %q", image.Item.ID, image.Image.Data)
+		}
+
+		ids = append(ids, image.Item.ID)
+
+		break
+	}
+
+	if len(ids) != 1 || ids[0] != "img1" {
+		t.Errorf("expected to stop after img1, got %v", ids)
+	}
+
+	if got := len(httpClient.recorded()); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+}
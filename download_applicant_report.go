@@ -0,0 +1,78 @@
+package gosumsub
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// mimeSniffLen is the number of bytes http.DetectContentType considers.
+const mimeSniffLen = 512
+
+const (
+	ReportTypeApplicant = "applicantReport"
+	ReportTypeCompany   = "companyReport"
+)
+
+var ErrReportTypeRequired = errors.New("report type is required")
+
+// ApplicantReport is a streamed report download. The caller must close Body.
+type ApplicantReport struct {
+	Body     io.ReadCloser
+	MimeType string
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func (c *Client) DownloadApplicantReport(
+	ctx context.Context,
+	applicantID, reportType, lang string,
+) (*ApplicantReport, error) {
+	if applicantID == "" {
+		return nil, ErrApplicantIDRequired
+	}
+
+	if reportType == "" {
+		return nil, ErrReportTypeRequired
+	}
+
+	query := url.Values{"report": []string{reportType}}
+	if lang != "" {
+		query.Set("lang", lang)
+	}
+
+	apiRequest := request{
+		Method:   http.MethodGet,
+		Endpoint: fmt.Sprintf("/resources/applicants/%s/summary/report", url.PathEscape(applicantID)),
+		Params:   nil,
+		Query:    query,
+		Header:   nil,
+		Body:     nil,
+		FullURL:  "",
+	}
+
+	resp, err := c.executeStream(ctx, &apiRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := bufio.NewReaderSize(resp.Body, mimeSniffLen)
+
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == "" {
+		sniff, _ := reader.Peek(mimeSniffLen)
+		contentType = resolveMimeType(contentType, sniff)
+	}
+
+	return &ApplicantReport{
+		Body:     readCloser{Reader: reader, Closer: resp.Body},
+		MimeType: contentType,
+	}, nil
+}
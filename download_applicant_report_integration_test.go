@@ -0,0 +1,39 @@
+package gosumsub_test
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+func TestIntegration_DownloadApplicantReport(t *testing.T) {
+	t.Parallel()
+
+	applicantID := strings.TrimSpace(os.Getenv("SUMSUB_TEST_APPLICANT_ID"))
+	if applicantID == "" {
+		t.Skip("skipping test: SUMSUB_TEST_APPLICANT_ID not set")
+	}
+
+	client := newTestClient(t)
+
+	report, err := client.DownloadApplicantReport(t.Context(), applicantID, gosumsub.ReportTypeApplicant, "en")
+	if err != nil {
+		t.Fatalf("DownloadApplicantReport failed: %v", err)
+	}
+
+	defer report.Body.Close()
+
+	size, err := io.Copy(io.Discard, report.Body)
+	if err != nil {
+		t.Fatalf("failed to read report: %v", err)
+	}
+
+	if size == 0 {
+		t.Error("expected non-empty report")
+	}
+
+	t.Logf("MimeType: %s, size: %d bytes", report.MimeType, size)
+}
@@ -0,0 +1,155 @@
+package gosumsub_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+const testPDFData = "%PDF-1.7\n%\xe2\xe3\xcf\xd3\n1 0 obj\n<<>>\nendobj\n"
+
+type closeTracker struct {
+	io.Reader
+
+	closed bool
+}
+
+func (c *closeTracker) Close() error {
+	c.closed = true
+
+	return nil
+}
+
+func TestDownloadApplicantReport_Success(t *testing.T) {
+	t.Parallel()
+
+	body := &closeTracker{Reader: strings.NewReader(testPDFData), closed: false}
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/pdf"}},
+			Body:       body,
+		}, nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	report, err := client.DownloadApplicantReport(t.Context(), "app123", gosumsub.ReportTypeApplicant, "en")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.MimeType != "application/pdf" {
+		t.Errorf("expected MimeType 'application/pdf', got %q", report.MimeType)
+	}
+
+	if body.closed {
+		t.Error("expected body to stay open until the caller closes it")
+	}
+
+	data, err := io.ReadAll(report.Body)
+	if err != nil {
+		t.Fatalf("failed to read report: %v", err)
+	}
+
+	if string(data) != testPDFData {
+		t.Errorf("unexpected report content %q", data)
+	}
+
+	if err := report.Body.Close(); err != nil || !body.closed {
+		t.Errorf("expected Close to close the response body, got %v", err)
+	}
+
+	recorded := httpClient.recorded()[0]
+	expectedURL := "https://api.example.com/resources/applicants/app123/summary/report?lang=en&report=applicantReport"
+
+	if recorded.method != http.MethodGet || recorded.url != expectedURL {
+		t.Errorf("expected GET %s, got %s %s", expectedURL, recorded.method, recorded.url)
+	}
+}
+
+func TestDownloadApplicantReport_DetectMimeTypeWhenHeaderMissing(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &mockHTTPClient{
+		response: &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(testPDFData)),
+		},
+		err: nil,
+	}
+
+	client := newMockClient(t, httpClient)
+
+	report, err := client.DownloadApplicantReport(t.Context(), "app123", gosumsub.ReportTypeCompany, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	defer report.Body.Close()
+
+	if report.MimeType != "application/pdf" {
+		t.Errorf("expected detected MimeType 'application/pdf', got %q", report.MimeType)
+	}
+
+	data, _ := io.ReadAll(report.Body)
+	if string(data) != testPDFData {
+		t.Error("expected sniffed bytes to remain readable")
+	}
+}
+
+func TestDownloadApplicantReport_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	_, err := client.DownloadApplicantReport(t.Context(), "", gosumsub.ReportTypeApplicant, "")
+	if !errors.Is(err, gosumsub.ErrApplicantIDRequired) {
+		t.Errorf("expected ErrApplicantIDRequired, got %v", err)
+	}
+
+	_, err = client.DownloadApplicantReport(t.Context(), "app123", "", "")
+	if !errors.Is(err, gosumsub.ErrReportTypeRequired) {
+		t.Errorf("expected ErrReportTypeRequired, got %v", err)
+	}
+}
+
+func TestDownloadApplicantReport_APIError(t *testing.T) {
+	t.Parallel()
+
+	body := `{"description":"Applicant not found","code":404,` +
+		`"correlationId":"abc123","errorCode":1001,"errorName":"NOT_FOUND"}`
+	httpClient := &mockHTTPClient{
+		response: &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		},
+		err: nil,
+	}
+
+	client := newMockClient(t, httpClient)
+
+	_, err := client.DownloadApplicantReport(t.Context(), "missing", gosumsub.ReportTypeApplicant, "en")
+
+	var apiErr *gosumsub.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+}
+
+func TestDownloadApplicantReport_HTTPError(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	_, err := client.DownloadApplicantReport(t.Context(), "app123", gosumsub.ReportTypeApplicant, "en")
+	if !errors.Is(err, gosumsub.ErrHTTPFailure) {
+		t.Errorf("expected ErrHTTPFailure, got %v", err)
+	}
+}
@@ -0,0 +1,34 @@
+package gosumsub_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIntegration_GenerateSDKAccessToken(t *testing.T) {
+	t.Parallel()
+
+	levelName := strings.TrimSpace(os.Getenv("SUMSUB_LEVEL_NAME"))
+	if levelName == "" {
+		t.Skip("skipping test: SUMSUB_LEVEL_NAME not set")
+	}
+
+	client := newTestClient(t)
+
+	token, err := client.GenerateSDKAccessToken(t.Context(), "test-user-integration", levelName, 10*time.Minute, nil)
+	if err != nil {
+		t.Fatalf("GenerateSDKAccessToken failed: %v", err)
+	}
+
+	if token.Token == "" {
+		t.Fatal("expected non-empty token")
+	}
+
+	if time.Until(token.ExpiresAt) <= 0 {
+		t.Errorf("expected ExpiresAt in the future, got %v", token.ExpiresAt)
+	}
+
+	t.Logf("Token expires at: %v", token.ExpiresAt)
+}
@@ -0,0 +1,125 @@
+package gosumsub_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+const testSDKAccessTokenResponseBody = `{"token":"_act-sbx-jwt-eyJhbGciOiJub25lIn0","userId":"user-123"}`
+
+func TestGenerateSDKAccessToken_Success(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, testSDKAccessTokenResponseBody), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	identifiers := &gosumsub.ApplicantIdentifiers{Email: "user@example.com", Phone: ""}
+
+	token, err := client.GenerateSDKAccessToken(t.Context(), "user-123", "basic-kyc-level", 10*time.Minute, identifiers)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if token.Token != "_act-sbx-jwt-eyJhbGciOiJub25lIn0" || token.UserID != "user-123" {
+		t.Errorf("unexpected token %+v", token)
+	}
+
+	expectedExpiry := time.Unix(1234567890, 0).Add(10 * time.Minute)
+	if !token.ExpiresAt.Equal(expectedExpiry) {
+		t.Errorf("expected ExpiresAt %v, got %v", expectedExpiry, token.ExpiresAt)
+	}
+
+	recorded := httpClient.recorded()[0]
+	if recorded.method != http.MethodPost || recorded.url != "https://api.example.com/resources/accessTokens/sdk" {
+		t.Errorf("unexpected request %s %s", recorded.method, recorded.url)
+	}
+
+	expectedBody := `{"userId":"user-123","levelName":"basic-kyc-level","ttlInSecs":600,` +
+		`"applicantIdentifiers":{"email":"user@example.com"}}`
+	if string(recorded.body) != expectedBody {
+		t.Errorf("expected body %s, got %s", expectedBody, recorded.body)
+	}
+}
+
+func TestGenerateSDKAccessToken_DefaultTTL(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, `{"token":"tok"}`), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	token, err := client.GenerateSDKAccessToken(t.Context(), "user-123", "basic-kyc-level", 0, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if token.UserID != "user-123" {
+		t.Errorf("expected UserID to default to the requested user, got %q", token.UserID)
+	}
+
+	if !token.ExpiresAt.Equal(time.Unix(1234567890, 0).Add(30 * time.Minute)) {
+		t.Errorf("unexpected ExpiresAt %v", token.ExpiresAt)
+	}
+
+	expectedBody := `{"userId":"user-123","levelName":"basic-kyc-level","ttlInSecs":1800}`
+	if body := string(httpClient.recorded()[0].body); body != expectedBody {
+		t.Errorf("expected body %s, got %s", expectedBody, body)
+	}
+}
+
+func TestGenerateSDKAccessToken_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	if _, err := client.GenerateSDKAccessToken(t.Context(), "", "lvl", 0, nil); !errors.Is(err, gosumsub.ErrUserIDRequired) {
+		t.Errorf("expected ErrUserIDRequired, got %v", err)
+	}
+
+	if _, err := client.GenerateSDKAccessToken(t.Context(), "u", "", 0, nil); !errors.Is(err, gosumsub.ErrLevelNameRequired) {
+		t.Errorf("expected ErrLevelNameRequired, got %v", err)
+	}
+
+	_, err := client.GenerateSDKAccessToken(t.Context(), "u", "lvl", time.Millisecond, nil)
+	if !errors.Is(err, gosumsub.ErrInvalidTTL) {
+		t.Errorf("expected ErrInvalidTTL, got %v", err)
+	}
+}
+
+func TestGenerateSDKAccessToken_UnknownLevel(t *testing.T) {
+	t.Parallel()
+
+	// Synthetic body, not a recorded Sumsub response; see APIErrorNameLevelNotFound.
+	body := `{"description":"Level 'missing' not found","code":404,"errorName":"` + gosumsub.APIErrorNameLevelNotFound + `"}`
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusNotFound, body), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	_, err := client.GenerateSDKAccessToken(t.Context(), "user-123", "missing", 0, nil)
+	if !errors.Is(err, gosumsub.ErrLevelNotFound) {
+		t.Errorf("expected ErrLevelNotFound, got %v", err)
+	}
+}
+
+func TestGenerateSDKAccessToken_HTTPError(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	_, err := client.GenerateSDKAccessToken(t.Context(), "user-123", "basic-kyc-level", 0, nil)
+	if !errors.Is(err, gosumsub.ErrHTTPFailure) {
+		t.Errorf("expected ErrHTTPFailure, got %v", err)
+	}
+}
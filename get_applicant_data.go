@@ -10,20 +10,21 @@ import (
 var ErrApplicantIDRequired = errors.New("applicantId is required")
 
 type IDDoc struct {
-	IDDocType   string `json:"idDocType,omitempty"`
-	Country     string `json:"country,omitempty"`
-	FirstName   string `json:"firstName,omitempty"`
-	FirstNameEn string `json:"firstNameEn,omitempty"`
-	LastName    string `json:"lastName,omitempty"`
-	LastNameEn  string `json:"lastNameEn,omitempty"`
-	IssuedDate  string `json:"issuedDate,omitempty"`
-	ValidUntil  string `json:"validUntil,omitempty"`
-	Number      string `json:"number,omitempty"`
-	Dob         string `json:"dob,omitempty"`
-	MrzLine1    string `json:"mrzLine1,omitempty"`
-	MrzLine2    string `json:"mrzLine2,omitempty"`
-	MrzLine3    string `json:"mrzLine3,omitempty"`
-	Termless    bool   `json:"termless,omitempty"`
+	IDDocType    string `json:"idDocType,omitempty"`
+	IDDocSubType string `json:"idDocSubType,omitempty"`
+	Country      string `json:"country,omitempty"`
+	FirstName    string `json:"firstName,omitempty"`
+	FirstNameEn  string `json:"firstNameEn,omitempty"`
+	LastName     string `json:"lastName,omitempty"`
+	LastNameEn   string `json:"lastNameEn,omitempty"`
+	IssuedDate   string `json:"issuedDate,omitempty"`
+	ValidUntil   string `json:"validUntil,omitempty"`
+	Number       string `json:"number,omitempty"`
+	Dob          string `json:"dob,omitempty"`
+	MrzLine1     string `json:"mrzLine1,omitempty"`
+	MrzLine2     string `json:"mrzLine2,omitempty"`
+	MrzLine3     string `json:"mrzLine3,omitempty"`
+	Termless     bool   `json:"termless,omitempty"`
 }
 
 type ApplicantInfo struct {
@@ -97,7 +98,12 @@ type RequiredIDDocs struct {
 }
 
 type ReviewResult struct {
-	ReviewAnswer string `json:"reviewAnswer,omitempty"`
+	ReviewAnswer      string   `json:"reviewAnswer,omitempty"`
+	ModerationComment string   `json:"moderationComment,omitempty"`
+	ClientComment     string   `json:"clientComment,omitempty"`
+	RejectLabels      []string `json:"rejectLabels,omitempty"`
+	ReviewRejectType  string   `json:"reviewRejectType,omitempty"`
+	ButtonIDs         []string `json:"buttonIds,omitempty"`
 }
 
 type Review struct {
@@ -117,24 +123,26 @@ type Review struct {
 }
 
 type ApplicantData struct {
-	ID                string          `json:"id,omitempty"`
-	CreatedAt         string          `json:"createdAt,omitempty"`
-	Key               string          `json:"key,omitempty"`
-	ClientID          string          `json:"clientId,omitempty"`
-	InspectionID      string          `json:"inspectionId,omitempty"`
-	ExternalUserID    string          `json:"externalUserId,omitempty"`
-	Info              *ApplicantInfo  `json:"info,omitempty"`
-	FixedInfo         *FixedInfo      `json:"fixedInfo,omitempty"`
-	Email             string          `json:"email,omitempty"`
-	Phone             string          `json:"phone,omitempty"`
-	PhoneCountry      string          `json:"phoneCountry,omitempty"`
-	ApplicantPlatform string          `json:"applicantPlatform,omitempty"`
-	Agreement         *Agreement      `json:"agreement,omitempty"`
-	RequiredIDDocs    *RequiredIDDocs `json:"requiredIdDocs,omitempty"`
-	Review            *Review         `json:"review,omitempty"`
-	Lang              string          `json:"lang,omitempty"`
-	Type              string          `json:"type,omitempty"`
-	Notes             []string        `json:"notes,omitempty"`
+	ID                string            `json:"id,omitempty"`
+	CreatedAt         string            `json:"createdAt,omitempty"`
+	Key               string            `json:"key,omitempty"`
+	ClientID          string            `json:"clientId,omitempty"`
+	InspectionID      string            `json:"inspectionId,omitempty"`
+	ExternalUserID    string            `json:"externalUserId,omitempty"`
+	Info              *ApplicantInfo    `json:"info,omitempty"`
+	FixedInfo         *FixedInfo        `json:"fixedInfo,omitempty"`
+	Email             string            `json:"email,omitempty"`
+	Phone             string            `json:"phone,omitempty"`
+	PhoneCountry      string            `json:"phoneCountry,omitempty"`
+	ApplicantPlatform string            `json:"applicantPlatform,omitempty"`
+	Agreement         *Agreement        `json:"agreement,omitempty"`
+	RequiredIDDocs    *RequiredIDDocs   `json:"requiredIdDocs,omitempty"`
+	Review            *Review           `json:"review,omitempty"`
+	Lang              string            `json:"lang,omitempty"`
+	Type              string            `json:"type,omitempty"`
+	Notes             []string          `json:"notes,omitempty"`
+	Tags              []string          `json:"tags,omitempty"`
+	Metadata          ApplicantMetadata `json:"metadata,omitempty"`
 }
 
 func (c *Client) GetApplicantData(ctx context.Context, applicantID string) (*ApplicantData, error) {
@@ -53,13 +53,16 @@ func (c *Client) GetDocumentImage(ctx context.Context, inspectionID, imageID str
 		return nil, err
 	}
 
-	mimeType := contentType
-	if mimeType == "" {
-		mimeType = http.DetectContentType(body)
-	}
-
 	return &GetDocumentImageResponse{
 		Data:     body,
-		MimeType: mimeType,
+		MimeType: resolveMimeType(contentType, body),
 	}, nil
 }
+
+func resolveMimeType(contentType string, data []byte) string {
+	if contentType != "" {
+		return contentType
+	}
+
+	return http.DetectContentType(data)
+}
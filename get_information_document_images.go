@@ -41,6 +41,18 @@ type DocumentImagesResponse struct {
 	TotalItems int                 `json:"totalItems,omitempty"`
 }
 
+func (r *DocumentImagesResponse) ActiveItems() []DocumentImageItem {
+	active := make([]DocumentImageItem, 0, len(r.Items))
+
+	for _, item := range r.Items {
+		if !item.Deactivated {
+			active = append(active, item)
+		}
+	}
+
+	return active
+}
+
 func (c *Client) GetInformationDocumentImages(ctx context.Context, applicantID string) (*DocumentImagesResponse, error) {
 	if applicantID == "" {
 		return nil, ErrApplicantIDRequired
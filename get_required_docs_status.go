@@ -0,0 +1,116 @@
+package gosumsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+)
+
+// IDDocSetType identifies a verification step of a level, such as IDENTITY or SELFIE.
+type IDDocSetType string
+
+const (
+	IDDocSetTypeIdentity          IDDocSetType = "IDENTITY"
+	IDDocSetTypeIdentity2         IDDocSetType = "IDENTITY2"
+	IDDocSetTypeSelfie            IDDocSetType = "SELFIE"
+	IDDocSetTypeProofOfResidence  IDDocSetType = "PROOF_OF_RESIDENCE"
+	IDDocSetTypeQuestionnaire     IDDocSetType = "QUESTIONNAIRE"
+	IDDocSetTypePhoneVerification IDDocSetType = "PHONE_VERIFICATION"
+	IDDocSetTypeEmailVerification IDDocSetType = "EMAIL_VERIFICATION"
+	IDDocSetTypeApplicantData     IDDocSetType = "APPLICANT_DATA"
+	IDDocSetTypeProofOfPayment    IDDocSetType = "PROOF_OF_PAYMENT"
+)
+
+type RequiredDocStepStatus struct {
+	ReviewResult       *ReviewResult            `json:"reviewResult,omitempty"`
+	Country            string                   `json:"country,omitempty"`
+	IDDocType          string                   `json:"idDocType,omitempty"`
+	ImageIDs           []int64                  `json:"imageIds,omitempty"`
+	ImageReviewResults map[string]*ReviewResult `json:"imageReviewResults,omitempty"`
+	Forbidden          bool                     `json:"forbidden,omitempty"`
+}
+
+func (s *RequiredDocStepStatus) IsApproved() bool {
+	return s != nil && s.ReviewResult != nil && s.ReviewResult.ReviewAnswer == ReviewAnswerGreen
+}
+
+// RequiredDocsStatus maps an IDDocSetType (IDENTITY, SELFIE, ...) to the status of that step.
+// Steps the applicant has not submitted yet are present with a nil value.
+type RequiredDocsStatus map[IDDocSetType]*RequiredDocStepStatus
+
+// BlockingSteps returns the steps that are not approved yet. When required is set, steps are
+// taken from its DocSets in level order, otherwise every step in the status map is checked.
+func (s RequiredDocsStatus) BlockingSteps(required *RequiredIDDocs) []IDDocSetType {
+	steps := make([]IDDocSetType, 0, len(s))
+
+	if required != nil {
+		for _, docSet := range required.DocSets {
+			steps = append(steps, IDDocSetType(docSet.IDDocSetType))
+		}
+	} else {
+		for step := range s {
+			steps = append(steps, step)
+		}
+
+		slices.Sort(steps)
+	}
+
+	blocking := make([]IDDocSetType, 0, len(steps))
+
+	for _, step := range steps {
+		if !s[step].IsApproved() {
+			blocking = append(blocking, step)
+		}
+	}
+
+	return blocking
+}
+
+// MissingSteps returns the steps of required that the applicant has not submitted anything for.
+func (s RequiredDocsStatus) MissingSteps(required *RequiredIDDocs) []IDDocSetType {
+	if required == nil {
+		return nil
+	}
+
+	missing := make([]IDDocSetType, 0, len(required.DocSets))
+
+	for _, docSet := range required.DocSets {
+		step := IDDocSetType(docSet.IDDocSetType)
+		if s[step] == nil {
+			missing = append(missing, step)
+		}
+	}
+
+	return missing
+}
+
+func (c *Client) GetRequiredDocsStatus(ctx context.Context, applicantID string) (RequiredDocsStatus, error) {
+	if applicantID == "" {
+		return nil, ErrApplicantIDRequired
+	}
+
+	apiRequest := request{
+		Method:   http.MethodGet,
+		Endpoint: fmt.Sprintf("/resources/applicants/%s/requiredIdDocsStatus", url.PathEscape(applicantID)),
+		Params:   nil,
+		Query:    nil,
+		Header:   nil,
+		Body:     nil,
+		FullURL:  "",
+	}
+
+	body, err := c.execute(ctx, &apiRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp RequiredDocsStatus
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,47 @@
+package gosumsub_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestIntegration_GetRequiredDocsStatus(t *testing.T) {
+	t.Parallel()
+
+	applicantID := strings.TrimSpace(os.Getenv("SUMSUB_TEST_APPLICANT_ID"))
+	if applicantID == "" {
+		t.Skip("skipping test: SUMSUB_TEST_APPLICANT_ID not set")
+	}
+
+	client := newTestClient(t)
+
+	status, err := client.GetRequiredDocsStatus(t.Context(), applicantID)
+	if err != nil {
+		t.Fatalf("GetRequiredDocsStatus failed: %v", err)
+	}
+
+	for step, stepStatus := range status {
+		t.Logf("Step %s: approved=%t", step, stepStatus.IsApproved())
+	}
+
+	applicant, err := client.GetApplicantData(t.Context(), applicantID)
+	if err != nil {
+		t.Fatalf("GetApplicantData failed: %v", err)
+	}
+
+	t.Logf("Blocking steps: %v", status.BlockingSteps(applicant.RequiredIDDocs))
+}
+
+func TestIntegration_GetRequiredDocsStatus_NonExistent(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+
+	_, err := client.GetRequiredDocsStatus(t.Context(), "non-existent-applicant-id")
+	if err == nil {
+		t.Fatal("expected error for non-existent applicant, got nil")
+	}
+
+	t.Logf("Expected error received: %v", err)
+}
@@ -0,0 +1,233 @@
+package gosumsub_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+const testRequiredDocsStatusResponseBody = `{
+	"IDENTITY": {
+		"reviewResult": {
+			"reviewAnswer": "GREEN"
+		},
+		"country": "VNM",
+		"idDocType": "ID_CARD",
+		"imageIds": [449741312, 1856600123],
+		"imageReviewResults": {
+			"449741312": {"reviewAnswer": "GREEN"},
+			"1856600123": {"reviewAnswer": "GREEN"}
+		},
+		"forbidden": false
+	},
+	"SELFIE": {
+		"reviewResult": {
+			"reviewAnswer": "RED",
+			"moderationComment": "The selfie is blurry.",
+			"rejectLabels": ["BAD_SELFIE"],
+			"reviewRejectType": "RETRY"
+		},
+		"idDocType": "SELFIE",
+		"imageIds": [1965113206],
+		"imageReviewResults": {
+			"1965113206": {"reviewAnswer": "RED", "rejectLabels": ["BAD_SELFIE"]}
+		}
+	},
+	"PROOF_OF_RESIDENCE": null
+}`
+
+func newTestDocSet(idDocSetType gosumsub.IDDocSetType) gosumsub.DocSet {
+	return gosumsub.DocSet{
+		IDDocSetType:            string(idDocSetType),
+		Types:                   nil,
+		VideoRequired:           "",
+		CaptureMode:             "",
+		UploaderMode:            "",
+		NfcVerificationSettings: nil,
+		Fields:                  nil,
+	}
+}
+
+func TestGetRequiredDocsStatus_Success(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, testRequiredDocsStatusResponseBody), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	resp, err := client.GetRequiredDocsStatus(t.Context(), "68a7d46b8a6f58bf219053c6")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	requests := httpClient.recorded()
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(requests))
+	}
+
+	expectedURL := "https://api.example.com/resources/applicants/68a7d46b8a6f58bf219053c6/requiredIdDocsStatus"
+	if requests[0].method != http.MethodGet || requests[0].url != expectedURL {
+		t.Errorf("expected GET %s, got %s %s", expectedURL, requests[0].method, requests[0].url)
+	}
+
+	identity := resp[gosumsub.IDDocSetTypeIdentity]
+	if identity == nil {
+		t.Fatal("expected IDENTITY step to be present")
+	}
+
+	if !identity.IsApproved() {
+		t.Error("expected IDENTITY step to be approved")
+	}
+
+	if len(identity.ImageIDs) != 2 || identity.ImageIDs[0] != 449741312 {
+		t.Errorf("unexpected IDENTITY image IDs: %v", identity.ImageIDs)
+	}
+
+	selfie := resp[gosumsub.IDDocSetTypeSelfie]
+	if selfie == nil || selfie.ReviewResult == nil {
+		t.Fatal("expected SELFIE step with review result")
+	}
+
+	if selfie.ReviewResult.ReviewRejectType != "RETRY" {
+		t.Errorf("expected ReviewRejectType 'RETRY', got %q", selfie.ReviewResult.ReviewRejectType)
+	}
+
+	if result := selfie.ImageReviewResults["1965113206"]; result == nil || result.ReviewAnswer != gosumsub.ReviewAnswerRed {
+		t.Errorf("expected RED image review result, got %+v", result)
+	}
+
+	poa, ok := resp[gosumsub.IDDocSetTypeProofOfResidence]
+	if !ok || poa != nil {
+		t.Errorf("expected PROOF_OF_RESIDENCE to be present with nil status, got %+v", poa)
+	}
+}
+
+func TestGetRequiredDocsStatus_BlockingSteps(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &mockHTTPClient{
+		response: &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(testRequiredDocsStatusResponseBody)),
+		},
+		err: nil,
+	}
+
+	client := newMockClient(t, httpClient)
+
+	resp, err := client.GetRequiredDocsStatus(t.Context(), "68a7d46b8a6f58bf219053c6")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	blocking := resp.BlockingSteps(nil)
+	expected := []gosumsub.IDDocSetType{gosumsub.IDDocSetTypeProofOfResidence, gosumsub.IDDocSetTypeSelfie}
+
+	if !slices.Equal(blocking, expected) {
+		t.Errorf("expected blocking steps %v, got %v", expected, blocking)
+	}
+
+	required := &gosumsub.RequiredIDDocs{
+		DocSets: []gosumsub.DocSet{
+			newTestDocSet(gosumsub.IDDocSetTypeIdentity),
+			newTestDocSet(gosumsub.IDDocSetTypeSelfie),
+			newTestDocSet(gosumsub.IDDocSetTypeQuestionnaire),
+		},
+	}
+
+	blocking = resp.BlockingSteps(required)
+	expected = []gosumsub.IDDocSetType{gosumsub.IDDocSetTypeSelfie, gosumsub.IDDocSetTypeQuestionnaire}
+
+	if !slices.Equal(blocking, expected) {
+		t.Errorf("expected blocking steps %v, got %v", expected, blocking)
+	}
+}
+
+func TestGetRequiredDocsStatus_ApplicantIDRequired(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &mockHTTPClient{
+		response: &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("{}")),
+		},
+		err: nil,
+	}
+
+	client := newMockClient(t, httpClient)
+
+	_, err := client.GetRequiredDocsStatus(t.Context(), "")
+	if !errors.Is(err, gosumsub.ErrApplicantIDRequired) {
+		t.Errorf("expected ErrApplicantIDRequired, got %v", err)
+	}
+}
+
+func TestGetRequiredDocsStatus_HTTPError(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &mockHTTPClient{
+		response: nil,
+		err:      context.DeadlineExceeded,
+	}
+
+	client := newMockClient(t, httpClient)
+
+	_, err := client.GetRequiredDocsStatus(t.Context(), "test-applicant-id")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestGetRequiredDocsStatus_NotFound(t *testing.T) {
+	t.Parallel()
+
+	body := `{"description":"Applicant not found","code":404,` +
+		`"correlationId":"abc123","errorCode":1001,"errorName":"NOT_FOUND"}`
+	httpClient := &mockHTTPClient{
+		response: &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		},
+		err: nil,
+	}
+
+	client := newMockClient(t, httpClient)
+
+	_, err := client.GetRequiredDocsStatus(t.Context(), "non-existent-id")
+
+	var apiErr *gosumsub.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+
+	if apiErr.Code != http.StatusNotFound {
+		t.Errorf("expected code 404, got %d", apiErr.Code)
+	}
+}
+
+func TestGetRequiredDocsStatus_InvalidJSON(t *testing.T) {
+	t.Parallel()
+
+	httpClient := &mockHTTPClient{
+		response: &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("invalid json")),
+		},
+		err: nil,
+	}
+
+	client := newMockClient(t, httpClient)
+
+	_, err := client.GetRequiredDocsStatus(t.Context(), "test-applicant-id")
+	if err == nil {
+		t.Fatal("expected error for invalid JSON response, got nil")
+	}
+}
@@ -0,0 +1,97 @@
+package gosumsub
+
+import (
+	"archive/zip"
+	"context"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	imageDirPerm  = 0o750
+	imageFilePerm = 0o600
+)
+
+// ImageSink receives the files written by DownloadAllApplicantImages. Put may be called
+// concurrently and names never contain path separators.
+type ImageSink interface {
+	Put(ctx context.Context, name string, data []byte) error
+}
+
+type DirectoryImageSink struct {
+	dir string
+}
+
+// NewDirectoryImageSink writes every file into dir, creating it if needed.
+func NewDirectoryImageSink(dir string) *DirectoryImageSink {
+	return &DirectoryImageSink{dir: dir}
+}
+
+func (s *DirectoryImageSink) Put(_ context.Context, name string, data []byte) error {
+	if err := os.MkdirAll(s.dir, imageDirPerm); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(s.dir, filepath.Base(name)), data, imageFilePerm)
+}
+
+// ZipImageSink writes files into a zip archive. Close must be called to finish the archive; it does
+// not close the underlying writer.
+type ZipImageSink struct {
+	mu     sync.Mutex
+	writer *zip.Writer
+}
+
+func NewZipImageSink(w io.Writer) *ZipImageSink {
+	return &ZipImageSink{mu: sync.Mutex{}, writer: zip.NewWriter(w)}
+}
+
+func (s *ZipImageSink) Put(_ context.Context, name string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := s.writer.Create(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+
+	return err
+}
+
+func (s *ZipImageSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.writer.Close()
+}
+
+type MemoryImageSink struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+func NewMemoryImageSink() *MemoryImageSink {
+	return &MemoryImageSink{mu: sync.Mutex{}, files: make(map[string][]byte)}
+}
+
+func (s *MemoryImageSink) Put(_ context.Context, name string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.files[name] = data
+
+	return nil
+}
+
+// Files returns a copy of the stored files keyed by name.
+func (s *MemoryImageSink) Files() map[string][]byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return maps.Clone(s.files)
+}
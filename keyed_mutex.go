@@ -0,0 +1,48 @@
+package gosumsub
+
+import "sync"
+
+type keyedLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{
+		mu:    sync.Mutex{},
+		locks: make(map[string]*keyedLock),
+	}
+}
+
+func (k *keyedMutex) lock(key string) func() {
+	k.mu.Lock()
+
+	entry, ok := k.locks[key]
+	if !ok {
+		entry = &keyedLock{mu: sync.Mutex{}, refs: 0}
+		k.locks[key] = entry
+	}
+
+	entry.refs++
+	k.mu.Unlock()
+
+	entry.mu.Lock()
+
+	return func() {
+		entry.mu.Unlock()
+
+		k.mu.Lock()
+
+		entry.refs--
+		if entry.refs == 0 {
+			delete(k.locks, key)
+		}
+
+		k.mu.Unlock()
+	}
+}
@@ -0,0 +1,143 @@
+package gosumsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultListApplicantsPageSize = 50
+	listApplicantsDateLayout      = "2006-01-02 15:04:05"
+)
+
+var ErrInvalidPagination = errors.New("offset must not be negative and limit must be positive")
+
+type ListApplicantsFilter struct {
+	ReviewStatus         string
+	LevelName            string
+	CreatedAfter         time.Time
+	CreatedBefore        time.Time
+	Tag                  string
+	ExternalUserIDPrefix string
+	PageSize             int
+}
+
+type ApplicantsPage struct {
+	Items      []ApplicantData `json:"items,omitempty"`
+	TotalItems int             `json:"totalItems,omitempty"`
+}
+
+type listApplicantsResponse struct {
+	List ApplicantsPage `json:"list"`
+}
+
+func (f *ListApplicantsFilter) query(offset, limit int) url.Values {
+	query := url.Values{
+		"offset": []string{strconv.Itoa(offset)},
+		"limit":  []string{strconv.Itoa(limit)},
+	}
+
+	if f == nil {
+		return query
+	}
+
+	setIfNotEmpty := func(key, value string) {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+
+	setIfNotEmpty("reviewStatus", f.ReviewStatus)
+	setIfNotEmpty("levelName", f.LevelName)
+	setIfNotEmpty("tag", f.Tag)
+	setIfNotEmpty("externalUserIdPrefix", f.ExternalUserIDPrefix)
+
+	if !f.CreatedAfter.IsZero() {
+		query.Set("createdAtFrom", f.CreatedAfter.UTC().Format(listApplicantsDateLayout))
+	}
+
+	if !f.CreatedBefore.IsZero() {
+		query.Set("createdAtTo", f.CreatedBefore.UTC().Format(listApplicantsDateLayout))
+	}
+
+	return query
+}
+
+func (c *Client) ListApplicantsPage(
+	ctx context.Context,
+	filter *ListApplicantsFilter,
+	offset, limit int,
+) (*ApplicantsPage, error) {
+	if offset < 0 || limit <= 0 {
+		return nil, ErrInvalidPagination
+	}
+
+	apiRequest := request{
+		Method:   http.MethodGet,
+		Endpoint: "/resources/applicants",
+		Params:   nil,
+		Query:    filter.query(offset, limit),
+		Header:   nil,
+		Body:     nil,
+		FullURL:  "",
+	}
+
+	body, err := c.execute(ctx, &apiRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp listApplicantsResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.List, nil
+}
+
+// ListApplicants iterates over every applicant matching filter, fetching pages of
+// filter.PageSize (50 by default) as the loop advances. Iteration stops after the first error,
+// which is yielded with a nil applicant.
+func (c *Client) ListApplicants(ctx context.Context, filter *ListApplicantsFilter) iter.Seq2[*ApplicantData, error] {
+	pageSize := defaultListApplicantsPageSize
+	if filter != nil && filter.PageSize > 0 {
+		pageSize = filter.PageSize
+	}
+
+	return func(yield func(*ApplicantData, error) bool) {
+		offset := 0
+
+		for {
+			page, err := c.ListApplicantsPage(ctx, filter, offset, pageSize)
+			if err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			for i := range page.Items {
+				if !yield(&page.Items[i], nil) {
+					return
+				}
+			}
+
+			offset += len(page.Items)
+
+			// The API may cap the limit below pageSize, so a short page only ends the listing when
+			// totalItems is absent.
+			if page.TotalItems > 0 {
+				if offset >= page.TotalItems || len(page.Items) == 0 {
+					return
+				}
+			} else if len(page.Items) < pageSize {
+				return
+			}
+		}
+	}
+}
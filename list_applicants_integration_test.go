@@ -0,0 +1,18 @@
+package gosumsub_test
+
+import (
+	"testing"
+)
+
+func TestIntegration_ListApplicantsPage(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t)
+
+	page, err := client.ListApplicantsPage(t.Context(), nil, 0, 5)
+	if err != nil {
+		t.Fatalf("ListApplicantsPage failed: %v", err)
+	}
+
+	t.Logf("Applicants: %d of %d", len(page.Items), page.TotalItems)
+}
@@ -0,0 +1,250 @@
+package gosumsub_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+func newApplicantListHTTPClient(total int) *recordingHTTPClient {
+	return newApplicantListHTTPClientWithTotal(total, 0, true)
+}
+
+// newApplicantListHTTPClientWithTotal serves total applicants, at most maxLimit per page when maxLimit
+// is positive, and omits totalItems unless reportTotal is set.
+func newApplicantListHTTPClientWithTotal(total, maxLimit int, reportTotal bool) *recordingHTTPClient {
+	return newRecordingHTTPClient(func(req *http.Request) (*http.Response, error) {
+		offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+
+		if maxLimit > 0 {
+			limit = min(limit, maxLimit)
+		}
+
+		items := make([]string, 0, limit)
+		for i := offset; i < total && i < offset+limit; i++ {
+			items = append(items, fmt.Sprintf(`{"id":"app-%d"}`, i))
+		}
+
+		if !reportTotal {
+			return newJSONResponse(http.StatusOK, fmt.Sprintf(`{"list":{"items":[%s]}}`, strings.Join(items, ","))), nil
+		}
+
+		return newJSONResponse(http.StatusOK, fmt.Sprintf(
+			`{"list":{"items":[%s],"totalItems":%d}}`, strings.Join(items, ","), total,
+		)), nil
+	})
+}
+
+func TestListApplicants_PagesThroughAllItems(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newApplicantListHTTPClient(5)
+	client := newMockClient(t, httpClient)
+
+	var ids []string
+
+	for applicant, err := range client.ListApplicants(t.Context(), &gosumsub.ListApplicantsFilter{PageSize: 2}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		ids = append(ids, applicant.ID)
+	}
+
+	if strings.Join(ids, ",") != "app-0,app-1,app-2,app-3,app-4" {
+		t.Errorf("unexpected applicants %v", ids)
+	}
+
+	requests := httpClient.recorded()
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 page requests, got %d", len(requests))
+	}
+
+	lastPage, _ := url.Parse(requests[2].url)
+	if got := lastPage.Query().Get("offset"); got != "4" {
+		t.Errorf("expected last page offset 4, got %q", got)
+	}
+}
+
+func TestListApplicants_WithoutTotalItems(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newApplicantListHTTPClientWithTotal(4, 0, false)
+	client := newMockClient(t, httpClient)
+
+	var ids []string
+
+	for applicant, err := range client.ListApplicants(t.Context(), &gosumsub.ListApplicantsFilter{PageSize: 2}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		ids = append(ids, applicant.ID)
+	}
+
+	if strings.Join(ids, ",") != "app-0,app-1,app-2,app-3" {
+		t.Errorf("unexpected applicants %v", ids)
+	}
+
+	if got := len(httpClient.recorded()); got != 3 {
+		t.Errorf("expected 3 page requests ending with an empty page, got %d", got)
+	}
+}
+
+func TestListApplicants_CappedPageSize(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newApplicantListHTTPClientWithTotal(5, 2, true)
+	client := newMockClient(t, httpClient)
+
+	var ids []string
+
+	for applicant, err := range client.ListApplicants(t.Context(), &gosumsub.ListApplicantsFilter{PageSize: 3}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		ids = append(ids, applicant.ID)
+	}
+
+	if strings.Join(ids, ",") != "app-0,app-1,app-2,app-3,app-4" {
+		t.Errorf("unexpected applicants %v", ids)
+	}
+
+	if got := len(httpClient.recorded()); got != 3 {
+		t.Errorf("expected 3 page requests, got %d", got)
+	}
+}
+
+func TestListApplicants_StopsEarly(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newApplicantListHTTPClient(10)
+	client := newMockClient(t, httpClient)
+
+	for applicant, err := range client.ListApplicants(t.Context(), &gosumsub.ListApplicantsFilter{PageSize: 3}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if applicant.ID == "app-1" {
+			break
+		}
+	}
+
+	if got := len(httpClient.recorded()); got != 1 {
+		t.Errorf("expected 1 page request, got %d", got)
+	}
+}
+
+func TestListApplicants_Filters(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newApplicantListHTTPClient(0)
+	client := newMockClient(t, httpClient)
+
+	filter := &gosumsub.ListApplicantsFilter{
+		ReviewStatus:         "completed",
+		LevelName:            "basic-kyc-level",
+		CreatedAfter:         time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		CreatedBefore:        time.Time{},
+		Tag:                  "vip",
+		ExternalUserIDPrefix: "user-",
+		PageSize:             0,
+	}
+
+	for _, err := range client.ListApplicants(t.Context(), filter) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	requests := httpClient.recorded()
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(requests))
+	}
+
+	parsed, err := url.Parse(requests[0].url)
+	if err != nil {
+		t.Fatalf("failed to parse request URL: %v", err)
+	}
+
+	query := parsed.Query()
+	expected := map[string]string{
+		"reviewStatus":         "completed",
+		"levelName":            "basic-kyc-level",
+		"createdAtFrom":        "2024-01-02 03:04:05",
+		"tag":                  "vip",
+		"externalUserIdPrefix": "user-",
+		"offset":               "0",
+		"limit":                "50",
+	}
+
+	for key, want := range expected {
+		if got := query.Get(key); got != want {
+			t.Errorf("expected %s=%q, got %q", key, want, got)
+		}
+	}
+
+	if query.Has("createdAtTo") {
+		t.Error("expected createdAtTo to be omitted")
+	}
+}
+
+func TestListApplicants_Error(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	count := 0
+
+	for applicant, err := range client.ListApplicants(t.Context(), nil) {
+		count++
+
+		if applicant != nil || !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected nil applicant and deadline error, got %v, %v", applicant, err)
+		}
+	}
+
+	if count != 1 {
+		t.Errorf("expected a single error yield, got %d", count)
+	}
+}
+
+func TestListApplicantsPage_TotalItems(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, newApplicantListHTTPClient(7))
+
+	page, err := client.ListApplicantsPage(t.Context(), nil, 5, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if page.TotalItems != 7 || len(page.Items) != 2 {
+		t.Errorf("expected 2 of 7 items, got %d of %d", len(page.Items), page.TotalItems)
+	}
+}
+
+func TestListApplicantsPage_InvalidPagination(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, newApplicantListHTTPClient(0))
+
+	if _, err := client.ListApplicantsPage(t.Context(), nil, -1, 10); !errors.Is(err, gosumsub.ErrInvalidPagination) {
+		t.Errorf("expected ErrInvalidPagination, got %v", err)
+	}
+
+	if _, err := client.ListApplicantsPage(t.Context(), nil, 0, 0); !errors.Is(err, gosumsub.ErrInvalidPagination) {
+		t.Errorf("expected ErrInvalidPagination, got %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package gosumsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Query parameters Sumsub appends to SuccessURL/RejectURL when Redirect.SignKey is set. The digest
+// is an HMAC of the raw query string that precedes it, with the digest parameters removed.
+const (
+	RedirectParamDigest    = "digest"
+	RedirectParamDigestAlg = "digestAlg"
+)
+
+const EchoContextKeyRedirectParams = "gosumsub.redirectParams"
+
+var (
+	ErrNilRedirectURL        = errors.New("redirect URL is nil")
+	ErrMissingRedirectDigest = errors.New("missing redirect digest parameter")
+	ErrRedirectAlgoRejected  = errors.New("redirect digest algorithm not allowed")
+)
+
+type redirectParamsContextKey struct{}
+
+// VerifyRedirectURL checks the signature Sumsub adds to Web SDK redirect URLs and returns the
+// signed query parameters, without the digest parameters. The digestAlg parameter is chosen by
+// whoever builds the URL, so it must be one of allowedAlgos, which defaults to AlgoHMACSHA256.
+func VerifyRedirectURL(u *url.URL, signKey string, allowedAlgos ...string) (url.Values, error) {
+	if u == nil {
+		return nil, ErrNilRedirectURL
+	}
+
+	var (
+		digest    string
+		digestAlg string
+		signed    []string
+	)
+
+	for pair := range strings.SplitSeq(u.RawQuery, "&") {
+		if pair == "" {
+			continue
+		}
+
+		rawKey, rawValue, _ := strings.Cut(pair, "=")
+
+		key, err := url.QueryUnescape(rawKey)
+		if err != nil {
+			return nil, ErrMalformedDigest
+		}
+
+		switch key {
+		case RedirectParamDigest:
+			digest, err = url.QueryUnescape(rawValue)
+		case RedirectParamDigestAlg:
+			digestAlg, err = url.QueryUnescape(rawValue)
+		default:
+			signed = append(signed, pair)
+		}
+
+		if err != nil {
+			return nil, ErrMalformedDigest
+		}
+	}
+
+	if digest == "" {
+		return nil, ErrMissingRedirectDigest
+	}
+
+	if digestAlg == "" {
+		digestAlg = AlgoHMACSHA256
+	}
+
+	if len(allowedAlgos) == 0 {
+		allowedAlgos = []string{AlgoHMACSHA256}
+	}
+
+	if !slices.Contains(allowedAlgos, digestAlg) {
+		return nil, fmt.Errorf("%w: %s", ErrRedirectAlgoRejected, digestAlg)
+	}
+
+	payload := strings.Join(signed, "&")
+
+	if err := VerifyWebhookDigest([]byte(payload), signKey, digestAlg, digest); err != nil {
+		return nil, err
+	}
+
+	params, err := url.ParseQuery(payload)
+	if err != nil {
+		return nil, ErrMalformedDigest
+	}
+
+	return params, nil
+}
+
+func RedirectParamsFromContext(ctx context.Context) (url.Values, bool) {
+	params, ok := ctx.Value(redirectParamsContextKey{}).(url.Values)
+
+	return params, ok
+}
+
+func RedirectVerificationMiddleware(signKey string, allowedAlgos ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			params, err := VerifyRedirectURL(request.URL, signKey, allowedAlgos...)
+			if err != nil {
+				http.Error(writer, "unauthorized", http.StatusUnauthorized)
+
+				return
+			}
+
+			ctx := context.WithValue(request.Context(), redirectParamsContextKey{}, params)
+
+			next.ServeHTTP(writer, request.WithContext(ctx))
+		})
+	}
+}
+
+func EchoRedirectVerificationMiddleware(signKey string, allowedAlgos ...string) func(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			params, err := VerifyRedirectURL(ctx.Request().URL, signKey, allowedAlgos...)
+			if err != nil {
+				return ctx.String(http.StatusUnauthorized, "unauthorized")
+			}
+
+			requestCtx := context.WithValue(ctx.Request().Context(), redirectParamsContextKey{}, params)
+			ctx.SetRequest(ctx.Request().WithContext(requestCtx))
+			ctx.Set(EchoContextKeyRedirectParams, params)
+
+			return next(ctx)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package gosumsub_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	testRedirectSignKey = "redirect-sign-key"
+	testRedirectQuery   = "status=completed&applicantId=app123&utm_source=email%20campaign"
+)
+
+func signedRedirectURL(t *testing.T, query, signKey string) *url.URL {
+	t.Helper()
+
+	parsed, err := url.Parse("https://example.com/kyc/success?" + query +
+		"&digest=" + computeHMACSHA256(query, signKey))
+	if err != nil {
+		t.Fatalf("failed to parse URL: %v", err)
+	}
+
+	return parsed
+}
+
+func TestVerifyRedirectURL_Valid(t *testing.T) {
+	t.Parallel()
+
+	params, err := gosumsub.VerifyRedirectURL(signedRedirectURL(t, testRedirectQuery, testRedirectSignKey), testRedirectSignKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if params.Get("status") != "completed" || params.Get("applicantId") != "app123" {
+		t.Errorf("unexpected params %v", params)
+	}
+
+	if params.Get("utm_source") != "email campaign" {
+		t.Errorf("expected decoded utm_source, got %q", params.Get("utm_source"))
+	}
+
+	if params.Has(gosumsub.RedirectParamDigest) {
+		t.Error("expected digest to be stripped from trusted params")
+	}
+}
+
+func TestVerifyRedirectURL_SHA512(t *testing.T) {
+	t.Parallel()
+
+	query := "status=rejected"
+	parsed, _ := url.Parse("https://example.com/kyc/reject?" + query +
+		"&digestAlg=" + gosumsub.AlgoHMACSHA512 + "&digest=" + computeHMACSHA512(query, testRedirectSignKey))
+
+	params, err := gosumsub.VerifyRedirectURL(parsed, testRedirectSignKey, gosumsub.AlgoHMACSHA512)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if params.Get("status") != "rejected" {
+		t.Errorf("expected status 'rejected', got %q", params.Get("status"))
+	}
+}
+
+func TestVerifyRedirectURL_AlgorithmNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	query := "status=completed"
+	parsed, _ := url.Parse("https://example.com/kyc/success?" + query +
+		"&digestAlg=" + gosumsub.AlgoHMACSHA1 + "&digest=" + computeHMACSHA1(query, testRedirectSignKey))
+
+	_, err := gosumsub.VerifyRedirectURL(parsed, testRedirectSignKey)
+	if !errors.Is(err, gosumsub.ErrRedirectAlgoRejected) {
+		t.Errorf("expected ErrRedirectAlgoRejected for a correctly signed SHA1 URL, got %v", err)
+	}
+
+	parsed, _ = url.Parse("https://example.com/kyc/reject?" + query +
+		"&digestAlg=" + gosumsub.AlgoHMACSHA512 + "&digest=" + computeHMACSHA512(query, testRedirectSignKey))
+
+	_, err = gosumsub.VerifyRedirectURL(parsed, testRedirectSignKey)
+	if !errors.Is(err, gosumsub.ErrRedirectAlgoRejected) {
+		t.Errorf("expected ErrRedirectAlgoRejected for SHA512 when only SHA256 is allowed, got %v", err)
+	}
+}
+
+func TestVerifyRedirectURL_TamperedStatus(t *testing.T) {
+	t.Parallel()
+
+	parsed := signedRedirectURL(t, "status=rejected&applicantId=app123", testRedirectSignKey)
+	parsed.RawQuery = strings.Replace(parsed.RawQuery, "status=rejected", "status=completed", 1)
+
+	_, err := gosumsub.VerifyRedirectURL(parsed, testRedirectSignKey)
+	if !errors.Is(err, gosumsub.ErrDigestMismatch) {
+		t.Errorf("expected ErrDigestMismatch, got %v", err)
+	}
+}
+
+func TestVerifyRedirectURL_Errors(t *testing.T) {
+	t.Parallel()
+
+	unsigned, _ := url.Parse("https://example.com/kyc/success?status=completed")
+
+	tests := []struct {
+		name    string
+		url     *url.URL
+		signKey string
+		wantErr error
+	}{
+		{name: "nil URL", url: nil, signKey: testRedirectSignKey, wantErr: gosumsub.ErrNilRedirectURL},
+		{name: "missing digest", url: unsigned, signKey: testRedirectSignKey, wantErr: gosumsub.ErrMissingRedirectDigest},
+		{
+			name:    "wrong sign key",
+			url:     signedRedirectURL(t, testRedirectQuery, testRedirectSignKey),
+			signKey: "other-key",
+			wantErr: gosumsub.ErrDigestMismatch,
+		},
+		{
+			name:    "empty sign key",
+			url:     signedRedirectURL(t, testRedirectQuery, testRedirectSignKey),
+			signKey: "",
+			wantErr: gosumsub.ErrEmptySecretKey,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := gosumsub.VerifyRedirectURL(testCase.url, testCase.signKey)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("expected %v, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRedirectVerificationMiddleware(t *testing.T) {
+	t.Parallel()
+
+	var status string
+
+	handler := http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		params, ok := gosumsub.RedirectParamsFromContext(request.Context())
+		if !ok {
+			t.Error("expected redirect params in context")
+		}
+
+		status = params.Get("status")
+
+		writer.WriteHeader(http.StatusOK)
+	})
+
+	wrapped := gosumsub.RedirectVerificationMiddleware(testRedirectSignKey)(handler)
+
+	signed := signedRedirectURL(t, testRedirectQuery, testRedirectSignKey)
+	rec := httptest.NewRecorder()
+	wrapped.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, signed.String(), nil))
+
+	if rec.Code != http.StatusOK || status != "completed" {
+		t.Errorf("expected 200 with status 'completed', got %d and %q", rec.Code, status)
+	}
+
+	rec = httptest.NewRecorder()
+	wrapped.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/kyc/success?status=completed", nil))
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d for unsigned URL, got %d", http.StatusUnauthorized, rec.Code)
+	}
+}
+
+func TestEchoRedirectVerificationMiddleware(t *testing.T) {
+	t.Parallel()
+
+	var status string
+
+	handler := func(ctx echo.Context) error {
+		params, ok := ctx.Get(gosumsub.EchoContextKeyRedirectParams).(url.Values)
+		if !ok {
+			t.Error("expected redirect params in echo context")
+		}
+
+		if _, ok := gosumsub.RedirectParamsFromContext(ctx.Request().Context()); !ok {
+			t.Error("expected redirect params in request context")
+		}
+
+		status = params.Get("status")
+
+		return ctx.String(http.StatusOK, "ok")
+	}
+
+	wrapped := gosumsub.EchoRedirectVerificationMiddleware(testRedirectSignKey)(handler)
+	echoInstance := echo.New()
+
+	signed := signedRedirectURL(t, testRedirectQuery, testRedirectSignKey)
+	rec := httptest.NewRecorder()
+
+	if err := wrapped(echoInstance.NewContext(httptest.NewRequest(http.MethodGet, signed.String(), nil), rec)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rec.Code != http.StatusOK || status != "completed" {
+		t.Errorf("expected 200 with status 'completed', got %d and %q", rec.Code, status)
+	}
+
+	rec = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/kyc/success?status=completed", nil)
+
+	if err := wrapped(echoInstance.NewContext(req, rec)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d for unsigned URL, got %d", http.StatusUnauthorized, rec.Code)
+	}
+}
@@ -0,0 +1,90 @@
+package gosumsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+var ErrMissingRequiredDocuments = errors.New("required documents are missing")
+
+type MissingDocumentsError struct {
+	Steps []IDDocSetType
+	Err   error
+}
+
+func (e *MissingDocumentsError) Error() string {
+	steps := make([]string, len(e.Steps))
+	for i, step := range e.Steps {
+		steps[i] = string(step)
+	}
+
+	return fmt.Sprintf("%s: %s (%v)", ErrMissingRequiredDocuments, strings.Join(steps, ", "), e.Err)
+}
+
+func (e *MissingDocumentsError) Unwrap() []error {
+	return []error{ErrMissingRequiredDocuments, e.Err}
+}
+
+// RequestApplicantCheck submits the applicant for review. When Sumsub rejects the request with 400 or
+// 409, the applicant's required steps are compared with the requiredIdDocsStatus breakdown and a
+// *MissingDocumentsError is returned if any step has not been submitted yet.
+func (c *Client) RequestApplicantCheck(ctx context.Context, applicantID, reason string) error {
+	if applicantID == "" {
+		return ErrApplicantIDRequired
+	}
+
+	var query url.Values
+	if reason != "" {
+		query = url.Values{"reason": []string{reason}}
+	}
+
+	apiRequest := request{
+		Method:   http.MethodPost,
+		Endpoint: fmt.Sprintf("/resources/applicants/%s/status/pending", url.PathEscape(applicantID)),
+		Params:   nil,
+		Query:    query,
+		Header:   nil,
+		Body:     nil,
+		FullURL:  "",
+	}
+
+	_, err := c.execute(ctx, &apiRequest)
+	if err != nil {
+		return c.diagnoseApplicantCheckError(ctx, applicantID, err)
+	}
+
+	return nil
+}
+
+func (c *Client) diagnoseApplicantCheckError(ctx context.Context, applicantID string, err error) error {
+	// Only a rejected submission can be explained by missing documents; authentication, not found and
+	// rate limit errors would only be repeated by the diagnostic calls.
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || (apiErr.Code != http.StatusBadRequest && apiErr.Code != http.StatusConflict) {
+		return err
+	}
+
+	applicant, applicantErr := c.GetApplicantData(ctx, applicantID)
+	if applicantErr != nil {
+		return err
+	}
+
+	status, statusErr := c.GetRequiredDocsStatus(ctx, applicantID)
+	if statusErr != nil {
+		return err
+	}
+
+	missing := status.MissingSteps(applicant.RequiredIDDocs)
+	if len(missing) == 0 {
+		return err
+	}
+
+	return &MissingDocumentsError{
+		Steps: missing,
+		Err:   err,
+	}
+}
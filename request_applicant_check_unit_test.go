@@ -0,0 +1,165 @@
+package gosumsub_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+const (
+	testPendingRejectedBody = `{"description":"Applicant documents are not complete","code":400,` +
+		`"correlationId":"abc123","errorCode":1003,"errorName":"BAD_REQUEST"}`
+	testApplicantWithRequiredDocsBody = `{
+		"id": "app123",
+		"requiredIdDocs": {
+			"docSets": [
+				{"idDocSetType": "IDENTITY", "types": ["PASSPORT", "ID_CARD"]},
+				{"idDocSetType": "SELFIE", "types": ["SELFIE"]}
+			]
+		}
+	}`
+)
+
+func newApplicantCheckHTTPClient(pendingStatus int, pendingBody, docsStatusBody string) *recordingHTTPClient {
+	return newRecordingHTTPClient(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case strings.HasSuffix(req.URL.Path, "/status/pending"):
+			return newJSONResponse(pendingStatus, pendingBody), nil
+		case strings.HasSuffix(req.URL.Path, "/one"):
+			return newJSONResponse(http.StatusOK, testApplicantWithRequiredDocsBody), nil
+		default:
+			return newJSONResponse(http.StatusOK, docsStatusBody), nil
+		}
+	})
+}
+
+func TestRequestApplicantCheck_Success(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newApplicantCheckHTTPClient(http.StatusOK, `{"ok":1}`, "{}")
+	client := newMockClient(t, httpClient)
+
+	err := client.RequestApplicantCheck(t.Context(), "app123", "docs uploaded")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	requests := httpClient.recorded()
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(requests))
+	}
+
+	expectedURL := "https://api.example.com/resources/applicants/app123/status/pending?reason=docs+uploaded"
+	if requests[0].method != http.MethodPost || requests[0].url != expectedURL {
+		t.Errorf("expected POST %s, got %s %s", expectedURL, requests[0].method, requests[0].url)
+	}
+}
+
+func TestRequestApplicantCheck_WithoutReason(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newApplicantCheckHTTPClient(http.StatusOK, `{"ok":1}`, "{}")
+	client := newMockClient(t, httpClient)
+
+	err := client.RequestApplicantCheck(t.Context(), "app123", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if url := httpClient.recorded()[0].url; strings.Contains(url, "?") {
+		t.Errorf("expected no query string, got %q", url)
+	}
+}
+
+func TestRequestApplicantCheck_MissingDocuments(t *testing.T) {
+	t.Parallel()
+
+	docsStatus := `{"IDENTITY": {"idDocType": "PASSPORT", "imageIds": [1]}, "SELFIE": null}`
+	httpClient := newApplicantCheckHTTPClient(http.StatusBadRequest, testPendingRejectedBody, docsStatus)
+	client := newMockClient(t, httpClient)
+
+	err := client.RequestApplicantCheck(t.Context(), "app123", "")
+	if !errors.Is(err, gosumsub.ErrMissingRequiredDocuments) {
+		t.Fatalf("expected ErrMissingRequiredDocuments, got %v", err)
+	}
+
+	var missingErr *gosumsub.MissingDocumentsError
+	if !errors.As(err, &missingErr) {
+		t.Fatalf("expected MissingDocumentsError, got %T", err)
+	}
+
+	if !slices.Equal(missingErr.Steps, []gosumsub.IDDocSetType{gosumsub.IDDocSetTypeSelfie}) {
+		t.Errorf("expected missing steps [SELFIE], got %v", missingErr.Steps)
+	}
+
+	var apiErr *gosumsub.APIError
+	if !errors.As(err, &apiErr) || apiErr.CorrelationID != "abc123" {
+		t.Errorf("expected wrapped APIError, got %v", err)
+	}
+}
+
+func TestRequestApplicantCheck_RejectedWithAllDocuments(t *testing.T) {
+	t.Parallel()
+
+	docsStatus := `{"IDENTITY": {"imageIds": [1]}, "SELFIE": {"imageIds": [2]}}`
+	httpClient := newApplicantCheckHTTPClient(http.StatusBadRequest, testPendingRejectedBody, docsStatus)
+	client := newMockClient(t, httpClient)
+
+	err := client.RequestApplicantCheck(t.Context(), "app123", "")
+	if errors.Is(err, gosumsub.ErrMissingRequiredDocuments) {
+		t.Fatal("did not expect ErrMissingRequiredDocuments when every step is submitted")
+	}
+
+	var apiErr *gosumsub.APIError
+	if !errors.As(err, &apiErr) {
+		t.Errorf("expected APIError, got %v", err)
+	}
+}
+
+func TestRequestApplicantCheck_OtherErrorsAreNotDiagnosed(t *testing.T) {
+	t.Parallel()
+
+	statuses := []int{
+		http.StatusUnauthorized,
+		http.StatusForbidden,
+		http.StatusNotFound,
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+	}
+
+	for _, status := range statuses {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			t.Parallel()
+
+			body := fmt.Sprintf(`{"description":%q,"code":%d}`, http.StatusText(status), status)
+			httpClient := newApplicantCheckHTTPClient(status, body, "{}")
+			client := newMockClient(t, httpClient)
+
+			err := client.RequestApplicantCheck(t.Context(), "app123", "")
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+
+			if got := len(httpClient.recorded()); got != 1 {
+				t.Errorf("expected no diagnostic requests, got %d requests", got)
+			}
+		})
+	}
+}
+
+func TestRequestApplicantCheck_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	err := client.RequestApplicantCheck(t.Context(), "", "")
+	if !errors.Is(err, gosumsub.ErrApplicantIDRequired) {
+		t.Errorf("expected ErrApplicantIDRequired, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package gosumsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+var ErrIDDocSetTypeRequired = errors.New("idDocSetType is required")
+
+// ResetApplicant resets all verification steps of the applicant. Sumsub notifies about the reset
+// with a WebhookTypeApplicantReset webhook.
+func (c *Client) ResetApplicant(ctx context.Context, applicantID string) error {
+	if applicantID == "" {
+		return ErrApplicantIDRequired
+	}
+
+	apiRequest := request{
+		Method:   http.MethodPost,
+		Endpoint: fmt.Sprintf("/resources/applicants/%s/reset", url.PathEscape(applicantID)),
+		Params:   nil,
+		Query:    nil,
+		Header:   nil,
+		Body:     nil,
+		FullURL:  "",
+	}
+
+	_, err := c.execute(ctx, &apiRequest)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ResetApplicantStep resets a single verification step, identified by one of the IDDocSetType*
+// constants (the same values as DocSet.IDDocSetType).
+func (c *Client) ResetApplicantStep(ctx context.Context, applicantID string, idDocSetType IDDocSetType) error {
+	if applicantID == "" {
+		return ErrApplicantIDRequired
+	}
+
+	if idDocSetType == "" {
+		return ErrIDDocSetTypeRequired
+	}
+
+	apiRequest := request{
+		Method: http.MethodPost,
+		Endpoint: fmt.Sprintf(
+			"/resources/applicants/%s/resetStep/%s",
+			url.PathEscape(applicantID),
+			url.PathEscape(string(idDocSetType)),
+		),
+		Params:  nil,
+		Query:   nil,
+		Header:  nil,
+		Body:    nil,
+		FullURL: "",
+	}
+
+	_, err := c.execute(ctx, &apiRequest)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
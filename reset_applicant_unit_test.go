@@ -0,0 +1,115 @@
+package gosumsub_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+func TestResetApplicant_Success(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, `{"ok":1}`), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	err := client.ResetApplicant(t.Context(), "app123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recorded := httpClient.recorded()[0]
+	if recorded.method != http.MethodPost || recorded.url != "https://api.example.com/resources/applicants/app123/reset" {
+		t.Errorf("unexpected request %s %s", recorded.method, recorded.url)
+	}
+}
+
+func TestResetApplicant_ApplicantIDRequired(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	err := client.ResetApplicant(t.Context(), "")
+	if !errors.Is(err, gosumsub.ErrApplicantIDRequired) {
+		t.Errorf("expected ErrApplicantIDRequired, got %v", err)
+	}
+}
+
+func TestResetApplicant_HTTPError(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	err := client.ResetApplicant(t.Context(), "app123")
+	if !errors.Is(err, gosumsub.ErrHTTPFailure) {
+		t.Errorf("expected ErrHTTPFailure, got %v", err)
+	}
+}
+
+func TestResetApplicantStep_Success(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, `{"ok":1}`), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	err := client.ResetApplicantStep(t.Context(), "app123", gosumsub.IDDocSetTypeSelfie)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recorded := httpClient.recorded()[0]
+	expectedURL := "https://api.example.com/resources/applicants/app123/resetStep/SELFIE"
+
+	if recorded.method != http.MethodPost || recorded.url != expectedURL {
+		t.Errorf("expected POST %s, got %s %s", expectedURL, recorded.method, recorded.url)
+	}
+}
+
+func TestResetApplicantStep_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	err := client.ResetApplicantStep(t.Context(), "", gosumsub.IDDocSetTypeSelfie)
+	if !errors.Is(err, gosumsub.ErrApplicantIDRequired) {
+		t.Errorf("expected ErrApplicantIDRequired, got %v", err)
+	}
+
+	err = client.ResetApplicantStep(t.Context(), "app123", "")
+	if !errors.Is(err, gosumsub.ErrIDDocSetTypeRequired) {
+		t.Errorf("expected ErrIDDocSetTypeRequired, got %v", err)
+	}
+}
+
+func TestResetApplicantStep_APIError(t *testing.T) {
+	t.Parallel()
+
+	body := `{"description":"Step is not present on the level","code":400,` +
+		`"correlationId":"abc123","errorCode":1003,"errorName":"BAD_REQUEST"}`
+	httpClient := &mockHTTPClient{
+		response: &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		},
+		err: nil,
+	}
+
+	client := newMockClient(t, httpClient)
+
+	err := client.ResetApplicantStep(t.Context(), "app123", gosumsub.IDDocSetTypeProofOfResidence)
+
+	var apiErr *gosumsub.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+package gosumsub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// SetImageActive marks an uploaded image as inactive, or restores a previously deactivated one.
+// The change is reflected in DocumentImageItem.Deactivated returned by GetInformationDocumentImages.
+func (c *Client) SetImageActive(ctx context.Context, inspectionID, imageID string, active bool) error {
+	if inspectionID == "" {
+		return ErrInspectionIDRequired
+	}
+
+	if imageID == "" {
+		return ErrImageIDRequired
+	}
+
+	var query url.Values
+	if active {
+		query = url.Values{"revert": []string{"true"}}
+	}
+
+	apiRequest := request{
+		Method:   http.MethodDelete,
+		Endpoint: fmt.Sprintf("/resources/inspections/%s/resources/%s", url.PathEscape(inspectionID), url.PathEscape(imageID)),
+		Params:   nil,
+		Query:    query,
+		Header:   nil,
+		Body:     nil,
+		FullURL:  "",
+	}
+
+	_, err := c.execute(ctx, &apiRequest)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package gosumsub_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+func TestSetImageActive_Deactivate(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, `{"ok":1}`), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	err := client.SetImageActive(t.Context(), "insp123", "449741312", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recorded := httpClient.recorded()[0]
+	expectedURL := "https://api.example.com/resources/inspections/insp123/resources/449741312"
+
+	if recorded.method != http.MethodDelete || recorded.url != expectedURL {
+		t.Errorf("expected DELETE %s, got %s %s", expectedURL, recorded.method, recorded.url)
+	}
+}
+
+func TestSetImageActive_Restore(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, `{"ok":1}`), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	err := client.SetImageActive(t.Context(), "insp123", "449741312", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recorded := httpClient.recorded()[0]
+	expectedURL := "https://api.example.com/resources/inspections/insp123/resources/449741312?revert=true"
+
+	if recorded.method != http.MethodDelete || recorded.url != expectedURL {
+		t.Errorf("expected DELETE %s, got %s %s", expectedURL, recorded.method, recorded.url)
+	}
+}
+
+func TestSetImageActive_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	if err := client.SetImageActive(t.Context(), "", "img", true); !errors.Is(err, gosumsub.ErrInspectionIDRequired) {
+		t.Errorf("expected ErrInspectionIDRequired, got %v", err)
+	}
+
+	if err := client.SetImageActive(t.Context(), "insp", "", true); !errors.Is(err, gosumsub.ErrImageIDRequired) {
+		t.Errorf("expected ErrImageIDRequired, got %v", err)
+	}
+}
+
+func TestSetImageActive_ReflectedInDocumentImages(t *testing.T) {
+	t.Parallel()
+
+	deactivated := map[string]bool{}
+	httpClient := newRecordingHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodDelete {
+			deactivated["449741312"] = req.URL.Query().Get("revert") != "true"
+
+			return newJSONResponse(http.StatusOK, `{"ok":1}`), nil
+		}
+
+		body := `{"items":[{"id":"449741312","deactivated":` +
+			strconv.FormatBool(deactivated["449741312"]) +
+			`},{"id":"1856600123"}],"totalItems":2}`
+
+		return newJSONResponse(http.StatusOK, body), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	if err := client.SetImageActive(t.Context(), "insp123", "449741312", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resp, err := client.GetInformationDocumentImages(t.Context(), "app123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	active := resp.ActiveItems()
+	if len(active) != 1 || active[0].ID != "1856600123" {
+		t.Errorf("expected only image 1856600123 to be active, got %+v", active)
+	}
+}
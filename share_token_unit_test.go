@@ -0,0 +1,208 @@
+package gosumsub_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+func TestGenerateShareToken_Success(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, `{"token":"_sht-abc","forClientId":"partner.com"}`), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	token, err := client.GenerateShareToken(t.Context(), "app123", "partner.com", time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if token.Token != "_sht-abc" || token.ForClientID != "partner.com" {
+		t.Errorf("unexpected token %+v", token)
+	}
+
+	if !token.ExpiresAt.Equal(time.Unix(1234567890, 0).Add(time.Hour)) {
+		t.Errorf("unexpected ExpiresAt %v", token.ExpiresAt)
+	}
+
+	recorded := httpClient.recorded()[0]
+	if recorded.method != http.MethodPost || recorded.url != "https://api.example.com/resources/accessTokens/shareToken" {
+		t.Errorf("unexpected request %s %s", recorded.method, recorded.url)
+	}
+
+	expectedBody := `{"applicantId":"app123","forClientId":"partner.com","ttlInSecs":3600}`
+	if string(recorded.body) != expectedBody {
+		t.Errorf("expected body %s, got %s", expectedBody, recorded.body)
+	}
+}
+
+func TestGenerateShareToken_DefaultTTL(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, `{"token":"_sht-abc"}`), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	token, err := client.GenerateShareToken(t.Context(), "app123", "partner.com", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !token.ExpiresAt.IsZero() || token.ForClientID != "partner.com" {
+		t.Errorf("unexpected token %+v", token)
+	}
+
+	if body := string(httpClient.recorded()[0].body); body != `{"applicantId":"app123","forClientId":"partner.com"}` {
+		t.Errorf("unexpected body %s", body)
+	}
+}
+
+func TestGenerateShareToken_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	if _, err := client.GenerateShareToken(t.Context(), "", "partner.com", 0); !errors.Is(err, gosumsub.ErrApplicantIDRequired) {
+		t.Errorf("expected ErrApplicantIDRequired, got %v", err)
+	}
+
+	if _, err := client.GenerateShareToken(t.Context(), "app123", "", 0); !errors.Is(err, gosumsub.ErrForClientIDRequired) {
+		t.Errorf("expected ErrForClientIDRequired, got %v", err)
+	}
+
+	_, err := client.GenerateShareToken(t.Context(), "app123", "partner.com", time.Millisecond)
+	if !errors.Is(err, gosumsub.ErrInvalidTTL) {
+		t.Errorf("expected ErrInvalidTTL, got %v", err)
+	}
+}
+
+func TestImportApplicantByShareToken_Success(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusOK, testApplicantDataResponseBody), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	applicant, err := client.ImportApplicantByShareToken(t.Context(), "_sht-abc", "basic-kyc-level")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if applicant.ID != "68a7d46b8a6f58bf219053c6" {
+		t.Errorf("unexpected applicant ID %q", applicant.ID)
+	}
+
+	recorded := httpClient.recorded()[0]
+	expectedURL := "https://api.example.com/resources/applicants/-/import?levelName=basic-kyc-level&shareToken=_sht-abc"
+
+	if recorded.method != http.MethodPost || recorded.url != expectedURL {
+		t.Errorf("expected POST %s, got %s %s", expectedURL, recorded.method, recorded.url)
+	}
+}
+
+func TestImportApplicantByShareToken_TypedErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		status      int
+		description string
+		errorName   string
+		wantErr     error
+	}{
+		{
+			name:        "expired",
+			status:      http.StatusBadRequest,
+			description: "Share token has expired",
+			errorName:   gosumsub.APIErrorNameShareTokenExpired,
+			wantErr:     gosumsub.ErrShareTokenExpired,
+		},
+		{
+			name:        "another client",
+			status:      http.StatusForbidden,
+			description: "Share token was issued for a different client",
+			errorName:   gosumsub.APIErrorNameShareTokenWrongClient,
+			wantErr:     gosumsub.ErrShareTokenWrongClient,
+		},
+		{
+			name:        "unknown level",
+			status:      http.StatusNotFound,
+			description: "Level 'x' not found",
+			errorName:   gosumsub.APIErrorNameLevelNotFound,
+			wantErr:     gosumsub.ErrLevelNotFound,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			body, _ := json.Marshal(map[string]any{
+				"description":   testCase.description,
+				"code":          testCase.status,
+				"correlationId": "abc123",
+				"errorName":     testCase.errorName,
+			})
+
+			httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+				return newJSONResponse(testCase.status, string(body)), nil
+			})
+
+			client := newMockClient(t, httpClient)
+
+			_, err := client.ImportApplicantByShareToken(t.Context(), "_sht-abc", "basic-kyc-level")
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("expected %v, got %v", testCase.wantErr, err)
+			}
+
+			var apiErr *gosumsub.APIError
+			if !errors.As(err, &apiErr) {
+				t.Errorf("expected wrapped APIError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestImportApplicantByShareToken_UntypedError(t *testing.T) {
+	t.Parallel()
+
+	body := `{"description":"Applicant already exists for this client","code":409,` +
+		`"correlationId":"abc123","errorCode":1003,"errorName":"BAD_REQUEST"}`
+	httpClient := newRecordingHTTPClient(func(_ *http.Request) (*http.Response, error) {
+		return newJSONResponse(http.StatusConflict, body), nil
+	})
+
+	client := newMockClient(t, httpClient)
+
+	_, err := client.ImportApplicantByShareToken(t.Context(), "_sht-abc", "basic-kyc-level")
+	if errors.Is(err, gosumsub.ErrShareTokenWrongClient) || errors.Is(err, gosumsub.ErrShareTokenExpired) {
+		t.Fatalf("did not expect a share token error, got %v", err)
+	}
+
+	var apiErr *gosumsub.APIError
+	if !errors.As(err, &apiErr) {
+		t.Errorf("expected APIError, got %v", err)
+	}
+}
+
+func TestImportApplicantByShareToken_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newMockClient(t, &mockHTTPClient{response: nil, err: context.DeadlineExceeded})
+
+	if _, err := client.ImportApplicantByShareToken(t.Context(), "", ""); !errors.Is(err, gosumsub.ErrShareTokenRequired) {
+		t.Errorf("expected ErrShareTokenRequired, got %v", err)
+	}
+}
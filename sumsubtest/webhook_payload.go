@@ -0,0 +1,193 @@
+// Package sumsubtest simulates Sumsub for local development and tests. It builds realistic webhook
+// payloads and sends them, signed, to a local handler.
+package sumsubtest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+// Default identifiers used in built payloads. They follow the shape of real Sumsub IDs.
+const (
+	DefaultApplicantID       = "5cb56e8e0a975a35f333cb83"
+	DefaultInspectionID      = "5cb56e8e0a975a35f333cb84"
+	DefaultApplicantActionID = "5cb56e8e0a975a35f333cb85"
+	DefaultExternalUserID    = "user-12345"
+	DefaultLevelName         = "basic-kyc-level"
+	DefaultClientID          = "local-client"
+)
+
+var ErrUnknownWebhookType = errors.New("unknown webhook type")
+
+// WebhookTypes lists every webhook type NewWebhookPayload can build.
+var WebhookTypes = []string{
+	gosumsub.WebhookTypeApplicantReviewed,
+	gosumsub.WebhookTypeApplicantPending,
+	gosumsub.WebhookTypeApplicantCreated,
+	gosumsub.WebhookTypeApplicantOnHold,
+	gosumsub.WebhookTypeApplicantPersonalInfoChanged,
+	gosumsub.WebhookTypeApplicantPrechecked,
+	gosumsub.WebhookTypeApplicantDeleted,
+	gosumsub.WebhookTypeApplicantLevelChanged,
+	gosumsub.WebhookTypeApplicantReset,
+	gosumsub.WebhookTypeApplicantActionPending,
+	gosumsub.WebhookTypeApplicantActionReviewed,
+	gosumsub.WebhookTypeApplicantActionOnHold,
+	gosumsub.WebhookTypeApplicantWorkflowCompleted,
+	gosumsub.WebhookTypeVideoIdentStatusChanged,
+}
+
+// WebhookPayload is a webhook body. Fields of the embedded payloads that do not apply to Type are
+// left empty and omitted from the JSON.
+type WebhookPayload struct {
+	gosumsub.WebhookEvent
+	gosumsub.WebhookActionPayload
+	gosumsub.WebhookVideoIdentPayload
+}
+
+// WebhookPayloadOption customizes a payload after the defaults for its type are applied.
+type WebhookPayloadOption func(*WebhookPayload)
+
+func WithApplicantID(applicantID string) WebhookPayloadOption {
+	return func(p *WebhookPayload) {
+		p.ApplicantID = applicantID
+	}
+}
+
+func WithExternalUserID(externalUserID string) WebhookPayloadOption {
+	return func(p *WebhookPayload) {
+		p.ExternalUserID = externalUserID
+	}
+}
+
+func WithLevelName(levelName string) WebhookPayloadOption {
+	return func(p *WebhookPayload) {
+		p.LevelName = levelName
+	}
+}
+
+func WithCorrelationID(correlationID string) WebhookPayloadOption {
+	return func(p *WebhookPayload) {
+		p.CorrelationID = correlationID
+	}
+}
+
+func WithCreatedAt(createdAt time.Time) WebhookPayloadOption {
+	return func(p *WebhookPayload) {
+		p.CreatedAtMs = createdAt.UTC().Format(gosumsub.WebhookTimeLayout)
+	}
+}
+
+func WithSandboxMode(sandboxMode bool) WebhookPayloadOption {
+	return func(p *WebhookPayload) {
+		p.SandboxMode = sandboxMode
+	}
+}
+
+// WithReviewRejected turns a reviewed payload into a rejection with the given labels.
+func WithReviewRejected(rejectType string, rejectLabels ...string) WebhookPayloadOption {
+	return func(p *WebhookPayload) {
+		p.ReviewResult = &gosumsub.ReviewResult{
+			ReviewAnswer:      gosumsub.ReviewAnswerRed,
+			ModerationComment: "We could not verify your identity.",
+			ClientComment:     "Rejected by the simulator.",
+			RejectLabels:      rejectLabels,
+			ReviewRejectType:  rejectType,
+			ButtonIDs:         nil,
+		}
+	}
+}
+
+// NewWebhookPayload builds a payload for webhookType with the fields Sumsub sends for that type.
+// CreatedAtMs is the current time unless WithCreatedAt is given.
+func NewWebhookPayload(webhookType string, opts ...WebhookPayloadOption) (*WebhookPayload, error) {
+	payload := &WebhookPayload{
+		WebhookEvent: gosumsub.WebhookEvent{
+			ApplicantID:    DefaultApplicantID,
+			InspectionID:   DefaultInspectionID,
+			CorrelationID:  fmt.Sprintf("req-%d", time.Now().UnixNano()),
+			ExternalUserID: DefaultExternalUserID,
+			LevelName:      DefaultLevelName,
+			Type:           webhookType,
+			ReviewStatus:   "",
+			ReviewResult:   nil,
+			CreatedAtMs:    time.Now().UTC().Format(gosumsub.WebhookTimeLayout),
+			SandboxMode:    true,
+			ClientID:       DefaultClientID,
+			ApplicantType:  "individual",
+			Raw:            nil,
+			Stale:          false,
+		},
+		WebhookActionPayload: gosumsub.WebhookActionPayload{
+			ApplicantActionID:         "",
+			ExternalApplicantActionID: "",
+		},
+		WebhookVideoIdentPayload: gosumsub.WebhookVideoIdentPayload{
+			VideoIdentReviewStatus: "",
+			ApplicantMemberOf:      nil,
+		},
+	}
+
+	switch webhookType {
+	case gosumsub.WebhookTypeApplicantCreated, gosumsub.WebhookTypeApplicantReset, gosumsub.WebhookTypeApplicantDeleted:
+		payload.ReviewStatus = "init"
+	case gosumsub.WebhookTypeApplicantPending, gosumsub.WebhookTypeApplicantLevelChanged:
+		payload.ReviewStatus = "pending"
+	case gosumsub.WebhookTypeApplicantPrechecked:
+		payload.ReviewStatus = "prechecked"
+	case gosumsub.WebhookTypeApplicantOnHold:
+		payload.ReviewStatus = "onHold"
+	case gosumsub.WebhookTypeApplicantReviewed, gosumsub.WebhookTypeApplicantWorkflowCompleted,
+		gosumsub.WebhookTypeApplicantPersonalInfoChanged:
+		payload.ReviewStatus = "completed"
+		payload.ReviewResult = approvedReviewResult()
+	case gosumsub.WebhookTypeApplicantActionPending:
+		payload.ReviewStatus = "pending"
+		payload.WebhookActionPayload = defaultActionPayload()
+	case gosumsub.WebhookTypeApplicantActionOnHold:
+		payload.ReviewStatus = "onHold"
+		payload.WebhookActionPayload = defaultActionPayload()
+	case gosumsub.WebhookTypeApplicantActionReviewed:
+		payload.ReviewStatus = "completed"
+		payload.ReviewResult = approvedReviewResult()
+		payload.WebhookActionPayload = defaultActionPayload()
+	case gosumsub.WebhookTypeVideoIdentStatusChanged:
+		payload.ReviewStatus = "pending"
+		payload.VideoIdentReviewStatus = "completed"
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownWebhookType, webhookType)
+	}
+
+	for _, opt := range opts {
+		opt(payload)
+	}
+
+	return payload, nil
+}
+
+// Marshal returns the JSON body Sumsub would send.
+func (p *WebhookPayload) Marshal() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+func approvedReviewResult() *gosumsub.ReviewResult {
+	return &gosumsub.ReviewResult{
+		ReviewAnswer:      gosumsub.ReviewAnswerGreen,
+		ModerationComment: "",
+		ClientComment:     "",
+		RejectLabels:      nil,
+		ReviewRejectType:  "",
+		ButtonIDs:         nil,
+	}
+}
+
+func defaultActionPayload() gosumsub.WebhookActionPayload {
+	return gosumsub.WebhookActionPayload{
+		ApplicantActionID:         DefaultApplicantActionID,
+		ExternalApplicantActionID: "action-12345",
+	}
+}
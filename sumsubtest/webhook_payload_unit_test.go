@@ -0,0 +1,120 @@
+package sumsubtest_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andyle182810/gosumsub"
+	"github.com/andyle182810/gosumsub/sumsubtest"
+)
+
+func TestNewWebhookPayload_AllTypes(t *testing.T) {
+	t.Parallel()
+
+	for _, webhookType := range sumsubtest.WebhookTypes {
+		t.Run(webhookType, func(t *testing.T) {
+			t.Parallel()
+
+			payload, err := sumsubtest.NewWebhookPayload(webhookType)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			body, err := payload.Marshal()
+			if err != nil {
+				t.Fatalf("failed to marshal payload: %v", err)
+			}
+
+			event, err := gosumsub.ParseWebhook(body)
+			if err != nil {
+				t.Fatalf("failed to parse payload: %v", err)
+			}
+
+			if event.Type != webhookType || event.ApplicantID != sumsubtest.DefaultApplicantID || event.ReviewStatus == "" {
+				t.Errorf("unexpected event %+v", event)
+			}
+
+			if _, err := event.CreatedAt(); err != nil {
+				t.Errorf("expected parsable createdAtMs, got %v", err)
+			}
+
+			if event.IsAction() {
+				action, err := event.Action()
+				if err != nil || action.ApplicantActionID != sumsubtest.DefaultApplicantActionID {
+					t.Errorf("expected action payload, got %+v (%v)", action, err)
+				}
+			}
+		})
+	}
+}
+
+func TestNewWebhookPayload_VideoIdent(t *testing.T) {
+	t.Parallel()
+
+	payload, err := sumsubtest.NewWebhookPayload(gosumsub.WebhookTypeVideoIdentStatusChanged)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	body, _ := payload.Marshal()
+	event, _ := gosumsub.ParseWebhook(body)
+
+	videoIdent, err := event.VideoIdent()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if videoIdent.VideoIdentReviewStatus != "completed" {
+		t.Errorf("expected videoIdentReviewStatus 'completed', got %q", videoIdent.VideoIdentReviewStatus)
+	}
+}
+
+func TestNewWebhookPayload_Options(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, 3, 5, 10, 20, 30, 456000000, time.UTC)
+
+	payload, err := sumsubtest.NewWebhookPayload(
+		gosumsub.WebhookTypeApplicantReviewed,
+		sumsubtest.WithApplicantID("app123"),
+		sumsubtest.WithExternalUserID("ext-1"),
+		sumsubtest.WithLevelName("advanced"),
+		sumsubtest.WithCorrelationID("corr789"),
+		sumsubtest.WithCreatedAt(createdAt),
+		sumsubtest.WithSandboxMode(false),
+		sumsubtest.WithReviewRejected("FINAL", "FORGERY"),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	body, _ := payload.Marshal()
+
+	event, err := gosumsub.ParseWebhook(body)
+	if err != nil {
+		t.Fatalf("failed to parse payload: %v", err)
+	}
+
+	if event.ApplicantID != "app123" || event.ExternalUserID != "ext-1" || event.LevelName != "advanced" ||
+		event.CorrelationID != "corr789" || event.SandboxMode {
+		t.Errorf("unexpected event %+v", event)
+	}
+
+	if got, _ := event.CreatedAt(); !got.Equal(createdAt) {
+		t.Errorf("expected createdAt %v, got %v", createdAt, got)
+	}
+
+	if event.ReviewResult == nil || event.ReviewResult.ReviewAnswer != gosumsub.ReviewAnswerRed ||
+		event.ReviewResult.ReviewRejectType != "FINAL" || len(event.ReviewResult.RejectLabels) != 1 {
+		t.Errorf("unexpected review result %+v", event.ReviewResult)
+	}
+}
+
+func TestNewWebhookPayload_UnknownType(t *testing.T) {
+	t.Parallel()
+
+	if _, err := sumsubtest.NewWebhookPayload("applicantExploded"); !errors.Is(err, sumsubtest.ErrUnknownWebhookType) {
+		t.Errorf("expected ErrUnknownWebhookType, got %v", err)
+	}
+}
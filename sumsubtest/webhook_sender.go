@@ -0,0 +1,104 @@
+package sumsubtest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+// WebhookSender POSTs signed webhooks to a local endpoint, the way Sumsub delivers them.
+type WebhookSender struct {
+	url        string
+	secretKey  string
+	algo       string
+	httpClient gosumsub.HTTPClient
+}
+
+type WebhookSenderOption func(*WebhookSender)
+
+// WithSenderAlgo sets the digest algorithm, one of the gosumsub.AlgoHMAC* constants. The default is
+// gosumsub.AlgoHMACSHA256.
+func WithSenderAlgo(algo string) WebhookSenderOption {
+	return func(s *WebhookSender) {
+		s.algo = algo
+	}
+}
+
+func WithSenderHTTPClient(httpClient gosumsub.HTTPClient) WebhookSenderOption {
+	return func(s *WebhookSender) {
+		if httpClient != nil {
+			s.httpClient = httpClient
+		}
+	}
+}
+
+func NewWebhookSender(url, secretKey string, opts ...WebhookSenderOption) *WebhookSender {
+	sender := &WebhookSender{
+		url:        url,
+		secretKey:  secretKey,
+		algo:       gosumsub.AlgoHMACSHA256,
+		httpClient: http.DefaultClient,
+	}
+
+	for _, opt := range opts {
+		opt(sender)
+	}
+
+	return sender
+}
+
+// NewRequest returns the signed request Send would make for payload.
+func (s *WebhookSender) NewRequest(ctx context.Context, payload []byte) (*http.Request, error) {
+	digest, err := gosumsub.SignWebhook(payload, s.secretKey, s.algo)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(gosumsub.HeaderDigestAlg, s.algo)
+	request.Header.Set(gosumsub.HeaderDigest, digest)
+
+	return request, nil
+}
+
+// Send signs payload and POSTs it. The caller must close the response body.
+func (s *WebhookSender) Send(ctx context.Context, payload []byte) (*http.Response, error) {
+	request, err := s.NewRequest(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", gosumsub.ErrHTTPFailure, err)
+	}
+
+	return resp, nil
+}
+
+// SendEvent builds a payload with NewWebhookPayload and sends it.
+func (s *WebhookSender) SendEvent(
+	ctx context.Context,
+	webhookType string,
+	opts ...WebhookPayloadOption,
+) (*http.Response, error) {
+	payload, err := NewWebhookPayload(webhookType, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := payload.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Send(ctx, body)
+}
@@ -0,0 +1,125 @@
+package sumsubtest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+	"github.com/andyle182810/gosumsub/sumsubtest"
+)
+
+const testSecretKey = "sumsubtest-secret"
+
+func TestWebhookSender_AllAlgorithms(t *testing.T) {
+	t.Parallel()
+
+	for _, algo := range []string{gosumsub.AlgoHMACSHA1, gosumsub.AlgoHMACSHA256, gosumsub.AlgoHMACSHA512} {
+		t.Run(algo, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu       sync.Mutex
+				received []string
+			)
+
+			router := gosumsub.NewWebhookRouter(testSecretKey)
+			router.Fallback(func(_ context.Context, event *gosumsub.WebhookEvent) error {
+				mu.Lock()
+				defer mu.Unlock()
+
+				received = append(received, event.Type)
+
+				return nil
+			})
+
+			server := httptest.NewServer(router)
+			t.Cleanup(server.Close)
+
+			sender := sumsubtest.NewWebhookSender(server.URL, testSecretKey,
+				sumsubtest.WithSenderAlgo(algo), sumsubtest.WithSenderHTTPClient(server.Client()))
+
+			for _, webhookType := range sumsubtest.WebhookTypes {
+				resp, err := sender.SendEvent(t.Context(), webhookType)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				resp.Body.Close()
+
+				if resp.StatusCode != http.StatusOK {
+					t.Errorf("expected status 200 for %s, got %d", webhookType, resp.StatusCode)
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if len(received) != len(sumsubtest.WebhookTypes) {
+				t.Errorf("expected %d events, got %v", len(sumsubtest.WebhookTypes), received)
+			}
+		})
+	}
+}
+
+func TestWebhookSender_NewRequest(t *testing.T) {
+	t.Parallel()
+
+	payload := []byte(`{"applicantId":"app123","type":"applicantCreated"}`)
+	sender := sumsubtest.NewWebhookSender("http://localhost/webhook", testSecretKey)
+
+	request, err := sender.NewRequest(t.Context(), payload)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if request.Method != http.MethodPost || request.Header.Get(gosumsub.HeaderDigestAlg) != gosumsub.AlgoHMACSHA256 {
+		t.Errorf("unexpected request %s with headers %v", request.Method, request.Header)
+	}
+
+	body, err := gosumsub.VerifyWebhookRequestWithBody(request, testSecretKey)
+	if err != nil {
+		t.Fatalf("expected request to verify, got %v", err)
+	}
+
+	if string(body) != string(payload) {
+		t.Errorf("expected body %s, got %s", payload, body)
+	}
+}
+
+func TestWebhookSender_WrongSecretRejected(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(gosumsub.NewWebhookRouter(testSecretKey))
+	t.Cleanup(server.Close)
+
+	sender := sumsubtest.NewWebhookSender(server.URL, "other-secret", sumsubtest.WithSenderHTTPClient(server.Client()))
+
+	resp, err := sender.SendEvent(t.Context(), gosumsub.WebhookTypeApplicantReviewed)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected status %d, got %d", http.StatusUnauthorized, resp.StatusCode)
+	}
+}
+
+func TestWebhookSender_Errors(t *testing.T) {
+	t.Parallel()
+
+	sender := sumsubtest.NewWebhookSender("http://localhost/webhook", testSecretKey, sumsubtest.WithSenderAlgo("HMAC_MD5_HEX"))
+
+	if _, err := sender.Send(t.Context(), []byte(`{}`)); !errors.Is(err, gosumsub.ErrUnsupportedAlgo) {
+		t.Errorf("expected ErrUnsupportedAlgo, got %v", err)
+	}
+
+	if _, err := sender.SendEvent(t.Context(), "applicantExploded"); !errors.Is(err, sumsubtest.ErrUnknownWebhookType) {
+		t.Errorf("expected ErrUnknownWebhookType, got %v", err)
+	}
+}
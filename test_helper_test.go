@@ -1,10 +1,13 @@
 package gosumsub_test
 
 import (
+	"bytes"
+	"io"
 	"log/slog"
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -20,6 +23,62 @@ func (m *mockHTTPClient) Do(_ *http.Request) (*http.Response, error) {
 	return m.response, m.err
 }
 
+type recordedRequest struct {
+	method string
+	url    string
+	header http.Header
+	body   []byte
+}
+
+type recordingHTTPClient struct {
+	mu       sync.Mutex
+	handler  func(request *http.Request) (*http.Response, error)
+	requests []recordedRequest
+}
+
+func newRecordingHTTPClient(handler func(request *http.Request) (*http.Response, error)) *recordingHTTPClient {
+	return &recordingHTTPClient{
+		mu:       sync.Mutex{},
+		handler:  handler,
+		requests: nil,
+	}
+}
+
+func (m *recordingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if req.Body != nil {
+		body, _ = io.ReadAll(req.Body)
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	m.mu.Lock()
+	m.requests = append(m.requests, recordedRequest{
+		method: req.Method,
+		url:    req.URL.String(),
+		header: req.Header.Clone(),
+		body:   body,
+	})
+	m.mu.Unlock()
+
+	return m.handler(req)
+}
+
+func (m *recordingHTTPClient) recorded() []recordedRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]recordedRequest(nil), m.requests...)
+}
+
+func newJSONResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
 type mockSigner struct {
 	signature string
 	err       error
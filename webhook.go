@@ -1,7 +1,6 @@
 package gosumsub
 
 import (
-	"bytes"
 	"crypto/hmac"
 	"crypto/sha1" //nolint:gosec
 	"crypto/sha256"
@@ -10,8 +9,8 @@ import (
 	"errors"
 	"fmt"
 	"hash"
-	"io"
 	"net/http"
+	"slices"
 
 	"github.com/labstack/echo/v4"
 )
@@ -37,6 +36,9 @@ var (
 	ErrMissingDigestAlgo = errors.New("missing digest algorithm header")
 	ErrMissingDigest     = errors.New("missing digest header")
 	ErrHMACWrite         = errors.New("failed to write payload to hmac")
+	ErrBodyTooLarge      = errors.New("webhook body too large")
+	ErrReadBody          = errors.New("failed to read webhook body")
+	ErrWebhookSecrets    = errors.New("failed to load webhook secrets")
 )
 
 const (
@@ -62,74 +64,115 @@ const (
 )
 
 func VerifyWebhookDigest(payload []byte, secretKey, algo, digestHex string) error {
-	if digestHex == "" {
-		return ErrEmptyDigest
-	}
+	_, err := verifyWebhookDigest(payload, []string{secretKey}, algo, digestHex)
+
+	return err
+}
 
+// SignWebhook returns the hex digest Sumsub sends in the X-Payload-Digest header for payload, signed
+// with secretKey using algo. It is meant for tests and local tools that simulate webhooks.
+func SignWebhook(payload []byte, secretKey, algo string) (string, error) {
 	if secretKey == "" {
-		return ErrEmptySecretKey
+		return "", ErrEmptySecretKey
 	}
 
-	var hashFunc func() hash.Hash
+	hashFunc, err := webhookHashFunc(algo)
+	if err != nil {
+		return "", err
+	}
 
-	switch algo {
-	case AlgoHMACSHA256:
-		hashFunc = sha256.New
-	case AlgoHMACSHA512:
-		hashFunc = sha512.New
-	case AlgoHMACSHA1:
-		hashFunc = sha1.New
-	default:
-		return fmt.Errorf("%w: %s", ErrUnsupportedAlgo, algo)
+	digest, err := webhookMAC(hashFunc, payload, secretKey)
+	if err != nil {
+		return "", err
 	}
 
-	mac := hmac.New(hashFunc, []byte(secretKey))
-	if _, err := mac.Write(payload); err != nil {
-		return fmt.Errorf("%w: %w", ErrHMACWrite, err)
+	return hex.EncodeToString(digest), nil
+}
+
+// verifyWebhookDigest computes the digest for every non-empty secret, without stopping at the first
+// match, and returns the index in secretKeys of the first secret that matches. Empty secrets, such as
+// an unset rotation slot, are skipped.
+func verifyWebhookDigest(payload []byte, secretKeys []string, algo, digestHex string) (int, error) {
+	if digestHex == "" {
+		return -1, ErrEmptyDigest
 	}
 
-	expected := mac.Sum(nil)
+	if !slices.ContainsFunc(secretKeys, func(secretKey string) bool { return secretKey != "" }) {
+		return -1, ErrEmptySecretKey
+	}
+
+	hashFunc, err := webhookHashFunc(algo)
+	if err != nil {
+		return -1, err
+	}
+
+	expected := make([][]byte, len(secretKeys))
+
+	for i, secretKey := range secretKeys {
+		if secretKey == "" {
+			continue
+		}
+
+		expected[i], err = webhookMAC(hashFunc, payload, secretKey)
+		if err != nil {
+			return -1, err
+		}
+	}
 
 	got, err := hex.DecodeString(digestHex)
 	if err != nil {
-		return ErrMalformedDigest
+		return -1, ErrMalformedDigest
 	}
 
-	if !hmac.Equal(expected, got) {
-		return ErrDigestMismatch
+	matched := -1
+
+	for i := range expected {
+		if expected[i] != nil && hmac.Equal(expected[i], got) && matched == -1 {
+			matched = i
+		}
+	}
+
+	if matched == -1 {
+		return -1, ErrDigestMismatch
 	}
 
-	return nil
+	return matched, nil
 }
 
-func VerifyWebhookRequest(request *http.Request, secretKey string) error {
-	body, err := io.ReadAll(request.Body)
-	if err != nil {
-		return fmt.Errorf("read body: %w", err)
+func webhookHashFunc(algo string) (func() hash.Hash, error) {
+	switch algo {
+	case AlgoHMACSHA256:
+		return sha256.New, nil
+	case AlgoHMACSHA512:
+		return sha512.New, nil
+	case AlgoHMACSHA1:
+		return sha1.New, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgo, algo)
 	}
-
-	return VerifyWebhookDigest(
-		body,
-		secretKey,
-		request.Header.Get(HeaderDigestAlg),
-		request.Header.Get(HeaderDigest),
-	)
 }
 
-func VerifyWebhookRequestWithBody(request *http.Request, secretKey string) ([]byte, error) {
-	body, err := io.ReadAll(request.Body)
-	if err != nil {
-		return nil, fmt.Errorf("read body: %w", err)
+func webhookMAC(hashFunc func() hash.Hash, payload []byte, secretKey string) ([]byte, error) {
+	mac := hmac.New(hashFunc, []byte(secretKey))
+	if _, err := mac.Write(payload); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrHMACWrite, err)
 	}
 
-	request.Body = io.NopCloser(bytes.NewReader(body))
+	return mac.Sum(nil), nil
+}
+
+// VerifyWebhookRequest checks the digest headers of request against secretKey. The body is read
+// without a size limit; WebhookMiddlewareWithOptions bounds it.
+func VerifyWebhookRequest(request *http.Request, secretKey string) error {
+	_, _, err := verifyWebhookRequest(request, StaticSecrets{secretKey}, noBodyLimit)
+
+	return err
+}
 
-	err = VerifyWebhookDigest(
-		body,
-		secretKey,
-		request.Header.Get(HeaderDigestAlg),
-		request.Header.Get(HeaderDigest),
-	)
+// VerifyWebhookRequestWithBody is VerifyWebhookRequest that also returns the verified body. The
+// request body can be read again afterwards.
+func VerifyWebhookRequestWithBody(request *http.Request, secretKey string) ([]byte, error) {
+	body, _, err := verifyWebhookRequest(request, StaticSecrets{secretKey}, noBodyLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -138,29 +181,13 @@ func VerifyWebhookRequestWithBody(request *http.Request, secretKey string) ([]by
 }
 
 func WebhookMiddleware(secretKey string) func(http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
-			_, err := VerifyWebhookRequestWithBody(request, secretKey)
-			if err != nil {
-				http.Error(writer, "unauthorized", http.StatusUnauthorized)
-
-				return
-			}
+	var options WebhookMiddlewareOptions
 
-			next.ServeHTTP(writer, request)
-		})
-	}
+	return WebhookMiddlewareWithOptions(secretKey, options)
 }
 
 func EchoWebhookMiddleware(secretKey string) func(next echo.HandlerFunc) echo.HandlerFunc {
-	return func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(ctx echo.Context) error {
-			_, err := VerifyWebhookRequestWithBody(ctx.Request(), secretKey)
-			if err != nil {
-				return ctx.String(http.StatusUnauthorized, "unauthorized")
-			}
-
-			return next(ctx)
-		}
-	}
+	var options WebhookMiddlewareOptions
+
+	return EchoWebhookMiddlewareWithOptions(secretKey, options)
 }
@@ -0,0 +1,71 @@
+package gosumsub_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+	"github.com/andyle182810/gosumsub/webhooktest"
+	"github.com/labstack/echo/v4"
+)
+
+func TestWebhookMiddleware_Conformance(t *testing.T) {
+	t.Parallel()
+
+	webhooktest.RunMiddlewareConformance(t, func(
+		_ *testing.T,
+		secretKey string,
+		options gosumsub.WebhookMiddlewareOptions,
+		observe func(webhooktest.Observation),
+	) func(*http.Request) (*http.Response, error) {
+		handler := gosumsub.WebhookMiddlewareWithOptions(secretKey, options)(
+			http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				body, _ := io.ReadAll(request.Body)
+				contextBody, _ := gosumsub.WebhookBodyFromContext(request.Context())
+				event, _ := gosumsub.WebhookEventFromContext(request.Context())
+
+				observe(webhooktest.Observation{Body: body, ContextBody: contextBody, Event: event})
+				writer.WriteHeader(http.StatusOK)
+			}),
+		)
+
+		return func(request *http.Request) (*http.Response, error) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, request)
+
+			return rec.Result(), nil
+		}
+	})
+}
+
+func TestEchoWebhookMiddleware_Conformance(t *testing.T) {
+	t.Parallel()
+
+	webhooktest.RunMiddlewareConformance(t, func(
+		_ *testing.T,
+		secretKey string,
+		options gosumsub.WebhookMiddlewareOptions,
+		observe func(webhooktest.Observation),
+	) func(*http.Request) (*http.Response, error) {
+		echoInstance := echo.New()
+		echoInstance.Use(gosumsub.EchoWebhookMiddlewareWithOptions(secretKey, options))
+		echoInstance.POST("/webhook", func(ctx echo.Context) error {
+			body, _ := io.ReadAll(ctx.Request().Body)
+			contextBody, _ := ctx.Get(gosumsub.EchoContextKeyWebhookBody).([]byte)
+			event, _ := ctx.Get(gosumsub.EchoContextKeyWebhookEvent).(*gosumsub.WebhookEvent)
+
+			observe(webhooktest.Observation{Body: body, ContextBody: contextBody, Event: event})
+
+			return ctx.NoContent(http.StatusOK)
+		})
+
+		return func(request *http.Request) (*http.Response, error) {
+			rec := httptest.NewRecorder()
+			echoInstance.ServeHTTP(rec, request)
+
+			return rec.Result(), nil
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package gosumsub
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	EchoContextKeyWebhookEvent = "gosumsub.webhookEvent"
+	EchoContextKeyWebhookBody  = "gosumsub.webhookBody"
+)
+
+type (
+	webhookEventContextKey struct{}
+	webhookBodyContextKey  struct{}
+)
+
+// WebhookEventFromContext returns the event parsed by the webhook middlewares. It is absent when the
+// verified body is not a webhook event.
+func WebhookEventFromContext(ctx context.Context) (*WebhookEvent, bool) {
+	event, ok := ctx.Value(webhookEventContextKey{}).(*WebhookEvent)
+
+	return event, ok
+}
+
+// WebhookBodyFromContext returns the raw body verified by the webhook middlewares.
+func WebhookBodyFromContext(ctx context.Context) ([]byte, bool) {
+	body, ok := ctx.Value(webhookBodyContextKey{}).([]byte)
+
+	return body, ok
+}
+
+// ContextWithWebhook returns a copy of ctx holding the verified body and, when it is a webhook event,
+// the parsed event, as the webhook middlewares do.
+func ContextWithWebhook(ctx context.Context, body []byte) context.Context {
+	ctx, _, _ = withWebhook(ctx, body)
+
+	return ctx
+}
+
+// withWebhook stores body and, when it parses, the event in ctx. The returned context holds the
+// body even when parsing fails.
+func withWebhook(ctx context.Context, body []byte) (context.Context, *WebhookEvent, error) {
+	ctx = context.WithValue(ctx, webhookBodyContextKey{}, body)
+
+	event, err := ParseWebhook(body)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	return withWebhookEvent(ctx, event), event, nil
+}
+
+func withWebhookEvent(ctx context.Context, event *WebhookEvent) context.Context {
+	return context.WithValue(ctx, webhookEventContextKey{}, event)
+}
+
+func setEchoWebhook(ctx echo.Context, body []byte) {
+	requestCtx, event, _ := withWebhook(ctx.Request().Context(), body)
+	ctx.SetRequest(ctx.Request().WithContext(requestCtx))
+	ctx.Set(EchoContextKeyWebhookBody, body)
+
+	if event != nil {
+		ctx.Set(EchoContextKeyWebhookEvent, event)
+	}
+}
+
+// webhookEventFromRequest returns the event stored by the webhook middlewares, or parses the body
+// without consuming it when the request did not go through them.
+func webhookEventFromRequest(request *http.Request) (*WebhookEvent, bool) {
+	if event, ok := WebhookEventFromContext(request.Context()); ok {
+		return event, true
+	}
+
+	return peekWebhookEvent(request)
+}
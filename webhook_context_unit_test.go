@@ -0,0 +1,138 @@
+package gosumsub_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+	"github.com/labstack/echo/v4"
+)
+
+func TestWebhookMiddleware_StoresBodyAndEvent(t *testing.T) {
+	t.Parallel()
+
+	var (
+		event *gosumsub.WebhookEvent
+		body  []byte
+	)
+
+	handler := gosumsub.WebhookMiddleware(testSecretKey)(
+		http.HandlerFunc(func(_ http.ResponseWriter, request *http.Request) {
+			event, _ = gosumsub.WebhookEventFromContext(request.Context())
+			body, _ = gosumsub.WebhookBodyFromContext(request.Context())
+		}),
+	)
+
+	handler.ServeHTTP(httptest.NewRecorder(), newSignedWebhookRequest(testReviewedWebhookBody, testSecretKey))
+
+	if event == nil || event.ApplicantID != "app123" || event.Type != gosumsub.WebhookTypeApplicantReviewed {
+		t.Errorf("unexpected event in context %+v", event)
+	}
+
+	if string(body) != testReviewedWebhookBody {
+		t.Errorf("unexpected body in context %q", body)
+	}
+}
+
+func TestWebhookMiddleware_NonEventBody(t *testing.T) {
+	t.Parallel()
+
+	var (
+		hasEvent bool
+		body     []byte
+	)
+
+	handler := gosumsub.WebhookMiddleware(testSecretKey)(
+		http.HandlerFunc(func(_ http.ResponseWriter, request *http.Request) {
+			_, hasEvent = gosumsub.WebhookEventFromContext(request.Context())
+			body, _ = gosumsub.WebhookBodyFromContext(request.Context())
+		}),
+	)
+
+	handler.ServeHTTP(httptest.NewRecorder(), newSignedWebhookRequest(testApplicantBody, testSecretKey))
+
+	if hasEvent {
+		t.Error("expected no event for a payload without type")
+	}
+
+	if string(body) != testApplicantBody {
+		t.Errorf("unexpected body in context %q", body)
+	}
+}
+
+func TestEchoWebhookMiddleware_StoresBodyAndEvent(t *testing.T) {
+	t.Parallel()
+
+	handler := gosumsub.EchoWebhookMiddleware(testEchoSecretKey)(func(ctx echo.Context) error {
+		event, ok := ctx.Get(gosumsub.EchoContextKeyWebhookEvent).(*gosumsub.WebhookEvent)
+		if !ok || event.CorrelationID != "corr789" {
+			t.Errorf("unexpected event in echo context %+v", event)
+		}
+
+		if body, ok := ctx.Get(gosumsub.EchoContextKeyWebhookBody).([]byte); !ok || string(body) != testReviewedWebhookBody {
+			t.Errorf("unexpected body in echo context %q", body)
+		}
+
+		if _, ok := gosumsub.WebhookEventFromContext(ctx.Request().Context()); !ok {
+			t.Error("expected event in request context")
+		}
+
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	req := newSignedWebhookRequest(testReviewedWebhookBody, testEchoSecretKey)
+	if err := handler(echo.New().NewContext(req, httptest.NewRecorder())); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestWebhookOrderMiddleware_FlagsEventInContext(t *testing.T) {
+	t.Parallel()
+
+	var staleFlags []bool
+
+	handler := gosumsub.WebhookMiddleware(testSecretKey)(
+		gosumsub.WebhookOrderMiddleware(gosumsub.NewMemoryOrderStore(), gosumsub.StaleWebhookFlag)(
+			http.HandlerFunc(func(_ http.ResponseWriter, request *http.Request) {
+				event, ok := gosumsub.WebhookEventFromContext(request.Context())
+				if !ok {
+					t.Error("expected event in context")
+
+					return
+				}
+
+				staleFlags = append(staleFlags, event.Stale)
+			}),
+		),
+	)
+
+	for _, body := range []string{testLaterReviewedWebhookBody, testPendingWebhookBody} {
+		handler.ServeHTTP(httptest.NewRecorder(), newSignedWebhookRequest(body, testSecretKey))
+	}
+
+	if len(staleFlags) != 2 || staleFlags[0] || !staleFlags[1] {
+		t.Errorf("expected [false true], got %v", staleFlags)
+	}
+}
+
+func TestWebhookRouter_HandlerContext(t *testing.T) {
+	t.Parallel()
+
+	router := gosumsub.NewWebhookRouter(testSecretKey)
+
+	var body []byte
+
+	router.OnApplicantReviewed(func(ctx context.Context, _ *gosumsub.ApplicantReviewedEvent) error {
+		body, _ = gosumsub.WebhookBodyFromContext(ctx)
+
+		return nil
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), newSignedWebhookRequest(testReviewedWebhookBody, testSecretKey))
+
+	if string(body) != testReviewedWebhookBody {
+		t.Errorf("unexpected body in handler context %q", body)
+	}
+}
@@ -0,0 +1,201 @@
+package gosumsub
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+)
+
+const defaultDedupCapacity = 10000
+
+// DedupStore remembers which webhook deliveries have been processed. Implementations must be safe
+// for concurrent use.
+type DedupStore interface {
+	// Claim records key and reports whether it had already been recorded.
+	Claim(ctx context.Context, key string) (bool, error)
+	// Forget removes key so that a redelivery of the event is processed again.
+	Forget(ctx context.Context, key string) error
+}
+
+// WebhookDedupKey identifies a webhook delivery. Sumsub retries send the same correlationId, type and
+// createdAtMs.
+func WebhookDedupKey(event *WebhookEvent) string {
+	return event.CorrelationID + "|" + event.Type + "|" + event.CreatedAtMs
+}
+
+// WebhookDedupMiddleware acknowledges deliveries that were already processed with 200 without
+// calling next. It must run after WebhookMiddleware. When next responds with 5xx the delivery is
+// forgotten, so the retry Sumsub sends is processed again.
+func WebhookDedupMiddleware(store DedupStore) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			key, ok := webhookDedupKeyFromRequest(request)
+			if !ok {
+				next.ServeHTTP(writer, request)
+
+				return
+			}
+
+			duplicate, err := store.Claim(request.Context(), key)
+			if err != nil {
+				http.Error(writer, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+				return
+			}
+
+			if duplicate {
+				writer.WriteHeader(http.StatusOK)
+
+				return
+			}
+
+			recorder := &statusRecorder{ResponseWriter: writer, status: http.StatusOK, wroteHeader: false}
+			next.ServeHTTP(recorder, request)
+
+			if recorder.status >= http.StatusInternalServerError {
+				_ = store.Forget(context.WithoutCancel(request.Context()), key)
+			}
+		})
+	}
+}
+
+func EchoWebhookDedupMiddleware(store DedupStore) func(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			key, ok := webhookDedupKeyFromRequest(ctx.Request())
+			if !ok {
+				return next(ctx)
+			}
+
+			duplicate, err := store.Claim(ctx.Request().Context(), key)
+			if err != nil {
+				return ctx.String(http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+			}
+
+			if duplicate {
+				return ctx.NoContent(http.StatusOK)
+			}
+
+			err = next(ctx)
+			if err != nil || ctx.Response().Status >= http.StatusInternalServerError {
+				_ = store.Forget(context.WithoutCancel(ctx.Request().Context()), key)
+			}
+
+			return err
+		}
+	}
+}
+
+// webhookDedupKeyFromRequest returns false for payloads that are not webhook events, which are
+// passed through.
+func webhookDedupKeyFromRequest(request *http.Request) (string, bool) {
+	event, ok := webhookEventFromRequest(request)
+	if !ok {
+		return "", false
+	}
+
+	return WebhookDedupKey(event), true
+}
+
+// peekWebhookEvent parses the request body without consuming it.
+func peekWebhookEvent(request *http.Request) (*WebhookEvent, bool) {
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		return nil, false
+	}
+
+	request.Body = io.NopCloser(bytes.NewReader(body))
+
+	event, err := ParseWebhook(body)
+	if err != nil {
+		return nil, false
+	}
+
+	return event, true
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(data []byte) (int, error) {
+	r.wroteHeader = true
+
+	return r.ResponseWriter.Write(data)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// MemoryDedupStore keeps the most recently claimed keys in memory, evicting the least recently
+// claimed key once capacity is reached.
+type MemoryDedupStore struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	entries  map[string]*list.Element
+}
+
+// NewMemoryDedupStore creates a store holding up to capacity keys, or 10000 when capacity is not
+// positive.
+func NewMemoryDedupStore(capacity int) *MemoryDedupStore {
+	if capacity <= 0 {
+		capacity = defaultDedupCapacity
+	}
+
+	return &MemoryDedupStore{
+		mu:       sync.Mutex{},
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+func (s *MemoryDedupStore) Claim(_ context.Context, key string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if element, ok := s.entries[key]; ok {
+		s.order.MoveToFront(element)
+
+		return true, nil
+	}
+
+	s.entries[key] = s.order.PushFront(key)
+
+	if s.order.Len() > s.capacity {
+		oldest, _ := s.order.Remove(s.order.Back()).(string)
+		delete(s.entries, oldest)
+	}
+
+	return false, nil
+}
+
+func (s *MemoryDedupStore) Forget(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if element, ok := s.entries[key]; ok {
+		s.order.Remove(element)
+		delete(s.entries, key)
+	}
+
+	return nil
+}
@@ -0,0 +1,336 @@
+package gosumsub
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultDedupTTL is how long FileDedupStore remembers a key. Sumsub stops retrying a webhook
+	// well before that.
+	DefaultDedupTTL = 7 * 24 * time.Hour
+
+	dedupFilePerm      = 0o600
+	dedupSweepsPerTTL  = 10
+	dedupCompactFactor = 2
+)
+
+var (
+	ErrInvalidDedupKey   = errors.New("dedup key must not contain line breaks")
+	ErrInvalidTableName  = errors.New("invalid table name")
+	errMalformedDedupLog = errors.New("malformed dedup log line")
+)
+
+var sqlIdentifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// FileDedupStore persists claimed keys in an append-only log file, so deduplication survives
+// restarts of a single process. Keys expire after the TTL; expired keys are dropped from memory as
+// the store is used, and the log is compacted on open and whenever it holds mostly dead records.
+type FileDedupStore struct {
+	mu        sync.Mutex
+	path      string
+	file      *os.File
+	keys      map[string]time.Time
+	ttl       time.Duration
+	clock     ClockFunc
+	records   int
+	lastSweep time.Time
+}
+
+type FileDedupStoreOption func(*FileDedupStore)
+
+// WithDedupTTL sets how long a claimed key is remembered. The default is DefaultDedupTTL.
+func WithDedupTTL(ttl time.Duration) FileDedupStoreOption {
+	return func(s *FileDedupStore) {
+		if ttl > 0 {
+			s.ttl = ttl
+		}
+	}
+}
+
+func WithDedupClock(clock ClockFunc) FileDedupStoreOption {
+	return func(s *FileDedupStore) {
+		if clock != nil {
+			s.clock = clock
+		}
+	}
+}
+
+func OpenFileDedupStore(path string, opts ...FileDedupStoreOption) (*FileDedupStore, error) {
+	store := &FileDedupStore{
+		mu:        sync.Mutex{},
+		path:      path,
+		file:      nil,
+		keys:      nil,
+		ttl:       DefaultDedupTTL,
+		clock:     time.Now,
+		records:   0,
+		lastSweep: time.Time{},
+	}
+
+	for _, opt := range opts {
+		opt(store)
+	}
+
+	now := store.clock()
+
+	keys, err := readDedupLog(path, now)
+	if err != nil {
+		return nil, err
+	}
+
+	store.keys = keys
+	store.expire(now)
+
+	if err := store.compact(); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (s *FileDedupStore) Claim(_ context.Context, key string) (bool, error) {
+	if strings.ContainsAny(key, "\r\n") {
+		return false, ErrInvalidDedupKey
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock()
+
+	if claimedAt, ok := s.keys[key]; ok && now.Sub(claimedAt) < s.ttl {
+		return true, nil
+	}
+
+	if _, err := s.file.WriteString(dedupClaimRecord(key, now)); err != nil {
+		return false, err
+	}
+
+	s.keys[key] = now
+	s.records++
+
+	s.maintain(now)
+
+	return false, nil
+}
+
+func (s *FileDedupStore) Forget(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.keys[key]; !ok {
+		return nil
+	}
+
+	if _, err := s.file.WriteString("-" + key + "\n"); err != nil {
+		return err
+	}
+
+	delete(s.keys, key)
+	s.records++
+
+	return nil
+}
+
+func (s *FileDedupStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
+
+// maintain drops expired keys at most dedupSweepsPerTTL times per TTL and compacts the log when
+// less than half of its records are still live. A failed compaction is retried at the next sweep,
+// the current log stays valid meanwhile. It must be called with s.mu held.
+func (s *FileDedupStore) maintain(now time.Time) {
+	if now.Sub(s.lastSweep) < s.ttl/dedupSweepsPerTTL {
+		return
+	}
+
+	s.expire(now)
+
+	if s.records > dedupCompactFactor*len(s.keys) {
+		_ = s.compact()
+	}
+}
+
+func (s *FileDedupStore) expire(now time.Time) {
+	for key, claimedAt := range s.keys {
+		if now.Sub(claimedAt) >= s.ttl {
+			delete(s.keys, key)
+		}
+	}
+
+	s.lastSweep = now
+}
+
+// compact rewrites the log with only the live keys and reopens it for appending.
+func (s *FileDedupStore) compact() error {
+	if err := writeDedupLog(s.path, s.keys); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND, dedupFilePerm)
+	if err != nil {
+		return err
+	}
+
+	if s.file != nil {
+		_ = s.file.Close()
+	}
+
+	s.file = file
+	s.records = len(s.keys)
+
+	return nil
+}
+
+func dedupClaimRecord(key string, claimedAt time.Time) string {
+	return "+" + strconv.FormatInt(claimedAt.Unix(), 10) + " " + key + "\n"
+}
+
+// readDedupLog replays the log. Claims are written as "+<unix time> <key>"; claims without a time,
+// from logs written before keys expired, count as claimed at now.
+func readDedupLog(path string, now time.Time) (map[string]time.Time, error) {
+	keys := make(map[string]time.Time)
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return keys, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		switch line[0] {
+		case '+':
+			key, claimedAt := parseDedupClaim(line[1:], now)
+			keys[key] = claimedAt
+		case '-':
+			delete(keys, line[1:])
+		default:
+			return nil, fmt.Errorf("%w: %q", errMalformedDedupLog, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func parseDedupClaim(record string, now time.Time) (string, time.Time) {
+	rawTime, key, ok := strings.Cut(record, " ")
+	if !ok {
+		return record, now
+	}
+
+	unix, err := strconv.ParseInt(rawTime, 10, 64)
+	if err != nil {
+		return record, now
+	}
+
+	return key, time.Unix(unix, 0)
+}
+
+func writeDedupLog(path string, keys map[string]time.Time) error {
+	tmpPath := path + ".tmp"
+
+	file, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, dedupFilePerm)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(file)
+
+	for key, claimedAt := range keys {
+		if _, err := writer.WriteString(dedupClaimRecord(key, claimedAt)); err != nil {
+			_ = file.Close()
+
+			return err
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// SQLDedupStore keeps claimed keys in a database table, so several processes can share them. The
+// queries use "?" placeholders and ON CONFLICT, which SQLite supports; the caller opens db with the
+// driver of their choice.
+type SQLDedupStore struct {
+	db    *sql.DB
+	table string
+}
+
+// NewSQLDedupStore creates table if it does not exist yet. The claimed_at column holds the Unix time
+// of the claim and can be used to prune old rows.
+func NewSQLDedupStore(ctx context.Context, db *sql.DB, table string) (*SQLDedupStore, error) {
+	if !sqlIdentifierPattern.MatchString(table) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTableName, table)
+	}
+
+	_, err := db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+table+
+		" (dedup_key TEXT PRIMARY KEY, claimed_at INTEGER NOT NULL)")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SQLDedupStore{db: db, table: table}, nil
+}
+
+func (s *SQLDedupStore) Claim(ctx context.Context, key string) (bool, error) {
+	result, err := s.db.ExecContext(ctx, "INSERT INTO "+s.table+
+		" (dedup_key, claimed_at) VALUES (?, ?) ON CONFLICT (dedup_key) DO NOTHING", key, time.Now().Unix())
+	if err != nil {
+		return false, err
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return inserted == 0, nil
+}
+
+func (s *SQLDedupStore) Forget(ctx context.Context, key string) error {
+	_, err := s.db.ExecContext(ctx, "DELETE FROM "+s.table+" WHERE dedup_key = ?", key)
+
+	return err
+}
@@ -0,0 +1,300 @@
+package gosumsub_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+func TestFileDedupStore_PersistsAcrossReopen(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "dedup.log")
+	ctx := t.Context()
+
+	store, err := gosumsub.OpenFileDedupStore(path)
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+
+	_, _ = store.Claim(ctx, "a")
+	_, _ = store.Claim(ctx, "b")
+
+	if err := store.Forget(ctx, "b"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := store.Close(); err != nil {
+		t.Fatalf("failed to close store: %v", err)
+	}
+
+	reopened, err := gosumsub.OpenFileDedupStore(path)
+	if err != nil {
+		t.Fatalf("failed to reopen store: %v", err)
+	}
+	defer reopened.Close()
+
+	if duplicate, _ := reopened.Claim(ctx, "a"); !duplicate {
+		t.Error("expected key a to survive reopening")
+	}
+
+	if duplicate, _ := reopened.Claim(ctx, "b"); duplicate {
+		t.Error("expected forgotten key b to be claimable")
+	}
+}
+
+func TestFileDedupStore_ExpiresKeys(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "dedup.log")
+	ctx := t.Context()
+	now := time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	store, err := gosumsub.OpenFileDedupStore(path, gosumsub.WithDedupTTL(time.Hour), gosumsub.WithDedupClock(clock))
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+	defer store.Close()
+
+	_, _ = store.Claim(ctx, "a")
+
+	now = now.Add(30 * time.Minute)
+
+	if duplicate, _ := store.Claim(ctx, "a"); !duplicate {
+		t.Error("expected key a to be remembered within the TTL")
+	}
+
+	now = now.Add(time.Hour)
+
+	if duplicate, _ := store.Claim(ctx, "a"); duplicate {
+		t.Error("expected key a to be claimable after the TTL")
+	}
+}
+
+func TestFileDedupStore_CompactsOnOpen(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "dedup.log")
+	ctx := t.Context()
+	now := time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	store, err := gosumsub.OpenFileDedupStore(path, gosumsub.WithDedupTTL(time.Hour), gosumsub.WithDedupClock(clock))
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+
+	for _, key := range []string{"a", "b", "c"} {
+		_, _ = store.Claim(ctx, key)
+	}
+
+	_ = store.Forget(ctx, "b")
+	_ = store.Close()
+
+	now = now.Add(2 * time.Hour)
+
+	reopened, err := gosumsub.OpenFileDedupStore(path, gosumsub.WithDedupTTL(time.Hour), gosumsub.WithDedupClock(clock))
+	if err != nil {
+		t.Fatalf("failed to reopen store: %v", err)
+	}
+
+	data, _ := os.ReadFile(path)
+	if len(data) != 0 {
+		t.Errorf("expected expired keys to be compacted away, got %q", data)
+	}
+
+	_, _ = reopened.Claim(ctx, "d")
+	_ = reopened.Close()
+
+	data, _ = os.ReadFile(path)
+	if lines := strings.Count(string(data), "\n"); lines != 1 {
+		t.Errorf("expected 1 record after compaction, got %d in %q", lines, data)
+	}
+}
+
+func TestFileDedupStore_CompactsWhileRunning(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "dedup.log")
+	ctx := t.Context()
+	now := time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	store, err := gosumsub.OpenFileDedupStore(path, gosumsub.WithDedupTTL(time.Hour), gosumsub.WithDedupClock(clock))
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+	defer store.Close()
+
+	for i := range 100 {
+		_, _ = store.Claim(ctx, fmt.Sprintf("key-%d", i))
+		now = now.Add(time.Minute)
+	}
+
+	data, _ := os.ReadFile(path)
+	if lines := strings.Count(string(data), "\n"); lines > 2*60 {
+		t.Errorf("expected the log to stay bounded by the TTL, got %d records", lines)
+	}
+
+	if duplicate, _ := store.Claim(ctx, "key-99"); !duplicate {
+		t.Error("expected the latest key to survive compaction")
+	}
+}
+
+func TestFileDedupStore_ReadsLogWithoutClaimTimes(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "dedup.log")
+	if err := os.WriteFile(path, []byte("+corr|applicantReviewed|2024-03-05 10:20:30.456\n"), 0o600); err != nil {
+		t.Fatalf("failed to write log: %v", err)
+	}
+
+	store, err := gosumsub.OpenFileDedupStore(path)
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+	defer store.Close()
+
+	if duplicate, _ := store.Claim(t.Context(), "corr|applicantReviewed|2024-03-05 10:20:30.456"); !duplicate {
+		t.Error("expected key from a log without claim times to be remembered")
+	}
+}
+
+func TestFileDedupStore_Errors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	store, err := gosumsub.OpenFileDedupStore(filepath.Join(dir, "dedup.log"))
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+	defer store.Close()
+
+	if _, err := store.Claim(t.Context(), "a\nb"); !errors.Is(err, gosumsub.ErrInvalidDedupKey) {
+		t.Errorf("expected ErrInvalidDedupKey, got %v", err)
+	}
+
+	corrupt := filepath.Join(dir, "corrupt.log")
+	if err := os.WriteFile(corrupt, []byte("+a\nbogus\n"), 0o600); err != nil {
+		t.Fatalf("failed to write log: %v", err)
+	}
+
+	if _, err := gosumsub.OpenFileDedupStore(corrupt); err == nil {
+		t.Error("expected error for corrupt log")
+	}
+}
+
+func TestNewSQLDedupStore_InvalidTableName(t *testing.T) {
+	t.Parallel()
+
+	_, err := gosumsub.NewSQLDedupStore(t.Context(), nil, "webhooks; DROP TABLE users")
+	if !errors.Is(err, gosumsub.ErrInvalidTableName) {
+		t.Errorf("expected ErrInvalidTableName, got %v", err)
+	}
+}
+
+// fakeDedupDriver understands just the statements SQLDedupStore issues.
+type fakeDedupDriver struct {
+	mu   sync.Mutex
+	keys map[string]bool
+}
+
+func (d *fakeDedupDriver) Open(string) (driver.Conn, error) {
+	return &fakeDedupConn{driver: d}, nil
+}
+
+type fakeDedupConn struct {
+	driver *fakeDedupDriver
+}
+
+func (c *fakeDedupConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.ErrUnsupported
+}
+
+func (c *fakeDedupConn) Close() error {
+	return nil
+}
+
+func (c *fakeDedupConn) Begin() (driver.Tx, error) {
+	return nil, errors.ErrUnsupported
+}
+
+func (c *fakeDedupConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.driver.mu.Lock()
+	defer c.driver.mu.Unlock()
+
+	switch {
+	case strings.HasPrefix(query, "CREATE TABLE"):
+		return driver.RowsAffected(0), nil
+	case strings.HasPrefix(query, "INSERT"):
+		key, _ := args[0].Value.(string)
+		if c.driver.keys[key] {
+			return driver.RowsAffected(0), nil
+		}
+
+		c.driver.keys[key] = true
+
+		return driver.RowsAffected(1), nil
+	case strings.HasPrefix(query, "DELETE"):
+		key, _ := args[0].Value.(string)
+		delete(c.driver.keys, key)
+
+		return driver.RowsAffected(1), nil
+	}
+
+	return nil, errors.ErrUnsupported
+}
+
+type fakeDedupConnector struct {
+	driver *fakeDedupDriver
+}
+
+func (c *fakeDedupConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.driver.Open("")
+}
+
+func (c *fakeDedupConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+func TestSQLDedupStore_ClaimAndForget(t *testing.T) {
+	t.Parallel()
+
+	db := sql.OpenDB(&fakeDedupConnector{driver: &fakeDedupDriver{mu: sync.Mutex{}, keys: map[string]bool{}}})
+	defer db.Close()
+
+	ctx := t.Context()
+
+	store, err := gosumsub.NewSQLDedupStore(ctx, db, "webhook_dedup")
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	if duplicate, err := store.Claim(ctx, "a"); err != nil || duplicate {
+		t.Fatalf("expected first claim to succeed, got %v, %v", duplicate, err)
+	}
+
+	if duplicate, _ := store.Claim(ctx, "a"); !duplicate {
+		t.Error("expected second claim to be a duplicate")
+	}
+
+	if err := store.Forget(ctx, "a"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if duplicate, _ := store.Claim(ctx, "a"); duplicate {
+		t.Error("expected forgotten key to be claimable")
+	}
+}
@@ -0,0 +1,199 @@
+package gosumsub_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+	"github.com/labstack/echo/v4"
+)
+
+func TestMemoryDedupStore_EvictsLeastRecentlyClaimed(t *testing.T) {
+	t.Parallel()
+
+	store := gosumsub.NewMemoryDedupStore(2)
+	ctx := t.Context()
+
+	for _, key := range []string{"a", "b", "a", "c"} {
+		if _, err := store.Claim(ctx, key); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if duplicate, _ := store.Claim(ctx, "a"); !duplicate {
+		t.Error("expected recently claimed key a to be kept")
+	}
+
+	if duplicate, _ := store.Claim(ctx, "b"); duplicate {
+		t.Error("expected key b to be evicted")
+	}
+}
+
+func TestMemoryDedupStore_Forget(t *testing.T) {
+	t.Parallel()
+
+	store := gosumsub.NewMemoryDedupStore(0)
+	ctx := t.Context()
+
+	_, _ = store.Claim(ctx, "a")
+
+	if err := store.Forget(ctx, "a"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if duplicate, _ := store.Claim(ctx, "a"); duplicate {
+		t.Error("expected forgotten key to be claimable again")
+	}
+}
+
+func TestWebhookDedupKey(t *testing.T) {
+	t.Parallel()
+
+	event, err := gosumsub.ParseWebhook([]byte(testReviewedWebhookBody))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := gosumsub.WebhookDedupKey(event); got != "corr789|applicantReviewed|2024-03-05 10:20:30.456" {
+		t.Errorf("unexpected key %q", got)
+	}
+}
+
+func TestWebhookDedupMiddleware_SkipsDuplicates(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	handler := gosumsub.WebhookMiddleware(testSecretKey)(
+		gosumsub.WebhookDedupMiddleware(gosumsub.NewMemoryDedupStore(0))(
+			http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+				calls.Add(1)
+				writer.WriteHeader(http.StatusAccepted)
+			}),
+		),
+	)
+
+	for range 3 {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newSignedWebhookRequest(testReviewedWebhookBody, testSecretKey))
+
+		if rec.Code != http.StatusAccepted && rec.Code != http.StatusOK {
+			t.Errorf("unexpected status %d", rec.Code)
+		}
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected next handler to run once, got %d", got)
+	}
+}
+
+func TestWebhookDedupMiddleware_ForgetsOnServerError(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	handler := gosumsub.WebhookDedupMiddleware(gosumsub.NewMemoryDedupStore(0))(
+		http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			if calls.Add(1) == 1 {
+				http.Error(writer, "try again", http.StatusServiceUnavailable)
+
+				return
+			}
+
+			writer.WriteHeader(http.StatusOK)
+		}),
+	)
+
+	for range 3 {
+		handler.ServeHTTP(httptest.NewRecorder(), newSignedWebhookRequest(testReviewedWebhookBody, testSecretKey))
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected the failed delivery to be retried once, got %d calls", got)
+	}
+}
+
+func TestWebhookDedupMiddleware_PassesThroughNonEvents(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	handler := gosumsub.WebhookDedupMiddleware(gosumsub.NewMemoryDedupStore(0))(
+		http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			calls.Add(1)
+		}),
+	)
+
+	for range 2 {
+		handler.ServeHTTP(httptest.NewRecorder(), newSignedWebhookRequest(`{"test":"data"}`, testSecretKey))
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected both requests to reach the handler, got %d", got)
+	}
+}
+
+func TestEchoWebhookDedupMiddleware(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	handler := gosumsub.EchoWebhookDedupMiddleware(gosumsub.NewMemoryDedupStore(0))(func(ctx echo.Context) error {
+		if calls.Add(1) == 1 {
+			return ctx.String(http.StatusInternalServerError, "boom")
+		}
+
+		return ctx.NoContent(http.StatusOK)
+	})
+	echoInstance := echo.New()
+
+	for range 3 {
+		req := newSignedWebhookRequest(testReviewedWebhookBody, testEchoSecretKey)
+		rec := httptest.NewRecorder()
+
+		if err := handler(echoInstance.NewContext(req, rec)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if rec.Code != http.StatusOK && rec.Code != http.StatusInternalServerError {
+			t.Errorf("unexpected status %d", rec.Code)
+		}
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected 2 handler calls, got %d", got)
+	}
+}
+
+func TestWebhookRouter_WithDedup(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	router := gosumsub.NewWebhookRouter(testSecretKey, gosumsub.WithWebhookDedup(gosumsub.NewMemoryDedupStore(0)))
+	router.OnApplicantReviewed(func(context.Context, *gosumsub.ApplicantReviewedEvent) error {
+		if calls.Add(1) == 1 {
+			return errHandlerFailed
+		}
+
+		return nil
+	})
+
+	wantStatuses := []int{http.StatusInternalServerError, http.StatusOK, http.StatusOK}
+
+	for i, want := range wantStatuses {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, newSignedWebhookRequest(testReviewedWebhookBody, testSecretKey))
+
+		if rec.Code != want {
+			t.Errorf("delivery %d: expected status %d, got %d", i, want, rec.Code)
+		}
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected 2 handler calls, got %d", got)
+	}
+}
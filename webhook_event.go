@@ -0,0 +1,126 @@
+package gosumsub
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// WebhookTimeLayout is the format of createdAtMs in webhook payloads. Sumsub sends it in UTC.
+const WebhookTimeLayout = "2006-01-02 15:04:05.000"
+
+var (
+	ErrMalformedWebhook    = errors.New("malformed webhook payload")
+	ErrMissingWebhookType  = errors.New("missing webhook type")
+	ErrWebhookTypeMismatch = errors.New("webhook type mismatch")
+)
+
+// WebhookEvent holds the fields common to every Sumsub webhook. Type-specific fields are available
+// through accessors such as Action and VideoIdent.
+type WebhookEvent struct {
+	ApplicantID    string        `json:"applicantId,omitempty"`
+	InspectionID   string        `json:"inspectionId,omitempty"`
+	CorrelationID  string        `json:"correlationId,omitempty"`
+	ExternalUserID string        `json:"externalUserId,omitempty"`
+	LevelName      string        `json:"levelName,omitempty"`
+	Type           string        `json:"type"`
+	ReviewStatus   string        `json:"reviewStatus,omitempty"`
+	ReviewResult   *ReviewResult `json:"reviewResult,omitempty"`
+	CreatedAtMs    string        `json:"createdAtMs,omitempty"`
+	SandboxMode    bool          `json:"sandboxMode,omitempty"`
+	ClientID       string        `json:"clientId,omitempty"`
+	ApplicantType  string        `json:"applicantType,omitempty"`
+
+	// Raw is the payload the event was parsed from.
+	Raw json.RawMessage `json:"-"`
+	// Stale is set by the ordering guard when a newer event for the same applicant was already seen.
+	Stale bool `json:"-"`
+}
+
+type WebhookActionPayload struct {
+	ApplicantActionID         string `json:"applicantActionId,omitempty"`
+	ExternalApplicantActionID string `json:"externalApplicantActionId,omitempty"`
+}
+
+type WebhookVideoIdentPayload struct {
+	VideoIdentReviewStatus string            `json:"videoIdentReviewStatus,omitempty"`
+	ApplicantMemberOf      []json.RawMessage `json:"applicantMemberOf,omitempty"`
+}
+
+func ParseWebhook(payload []byte) (*WebhookEvent, error) {
+	if len(payload) == 0 {
+		return nil, ErrEmptyPayload
+	}
+
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedWebhook, err)
+	}
+
+	if event.Type == "" {
+		return nil, ErrMissingWebhookType
+	}
+
+	event.Raw = json.RawMessage(payload)
+
+	return &event, nil
+}
+
+func (e *WebhookEvent) CreatedAt() (time.Time, error) {
+	createdAt, err := time.Parse(WebhookTimeLayout, e.CreatedAtMs)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %w", ErrMalformedWebhook, err)
+	}
+
+	return createdAt, nil
+}
+
+func (e *WebhookEvent) IsAction() bool {
+	switch e.Type {
+	case WebhookTypeApplicantActionPending, WebhookTypeApplicantActionReviewed, WebhookTypeApplicantActionOnHold:
+		return true
+	}
+
+	return false
+}
+
+// Action returns the applicant action fields of an applicantAction* event.
+func (e *WebhookEvent) Action() (*WebhookActionPayload, error) {
+	if !e.IsAction() {
+		return nil, fmt.Errorf("%w: %s is not an applicant action event", ErrWebhookTypeMismatch, e.Type)
+	}
+
+	var payload WebhookActionPayload
+	if err := e.decodeRaw(&payload); err != nil {
+		return nil, err
+	}
+
+	return &payload, nil
+}
+
+// VideoIdent returns the video identification fields of a videoIdentStatusChanged event.
+func (e *WebhookEvent) VideoIdent() (*WebhookVideoIdentPayload, error) {
+	if e.Type != WebhookTypeVideoIdentStatusChanged {
+		return nil, fmt.Errorf("%w: %s is not a video ident event", ErrWebhookTypeMismatch, e.Type)
+	}
+
+	var payload WebhookVideoIdentPayload
+	if err := e.decodeRaw(&payload); err != nil {
+		return nil, err
+	}
+
+	return &payload, nil
+}
+
+func (e *WebhookEvent) decodeRaw(target any) error {
+	if len(e.Raw) == 0 {
+		return ErrEmptyPayload
+	}
+
+	if err := json.Unmarshal(e.Raw, target); err != nil {
+		return fmt.Errorf("%w: %w", ErrMalformedWebhook, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package gosumsub_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+const testReviewedWebhookBody = `{
+	"applicantId": "app123",
+	"inspectionId": "insp456",
+	"correlationId": "corr789",
+	"externalUserId": "user-1",
+	"levelName": "basic-kyc-level",
+	"type": "applicantReviewed",
+	"reviewStatus": "completed",
+	"reviewResult": {"reviewAnswer": "RED", "rejectLabels": ["FORGERY"], "reviewRejectType": "FINAL"},
+	"createdAtMs": "2024-03-05 10:20:30.456",
+	"sandboxMode": true,
+	"clientId": "client-1"
+}`
+
+func TestParseWebhook_Reviewed(t *testing.T) {
+	t.Parallel()
+
+	event, err := gosumsub.ParseWebhook([]byte(testReviewedWebhookBody))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if event.Type != gosumsub.WebhookTypeApplicantReviewed || event.ApplicantID != "app123" {
+		t.Errorf("unexpected event %+v", event)
+	}
+
+	if event.InspectionID != "insp456" || event.CorrelationID != "corr789" || event.ExternalUserID != "user-1" {
+		t.Errorf("unexpected identifiers %+v", event)
+	}
+
+	if !event.SandboxMode || event.ClientID != "client-1" || event.LevelName != "basic-kyc-level" {
+		t.Errorf("unexpected metadata %+v", event)
+	}
+
+	if event.ReviewResult == nil || event.ReviewResult.ReviewAnswer != gosumsub.ReviewAnswerRed {
+		t.Fatalf("unexpected review result %+v", event.ReviewResult)
+	}
+
+	if len(event.ReviewResult.RejectLabels) != 1 || event.ReviewResult.RejectLabels[0] != "FORGERY" {
+		t.Errorf("unexpected reject labels %v", event.ReviewResult.RejectLabels)
+	}
+
+	createdAt, err := event.CreatedAt()
+	if err != nil {
+		t.Fatalf("unexpected CreatedAt error: %v", err)
+	}
+
+	if want := time.Date(2024, 3, 5, 10, 20, 30, 456000000, time.UTC); !createdAt.Equal(want) {
+		t.Errorf("expected %v, got %v", want, createdAt)
+	}
+
+	if _, err := event.Action(); !errors.Is(err, gosumsub.ErrWebhookTypeMismatch) {
+		t.Errorf("expected ErrWebhookTypeMismatch, got %v", err)
+	}
+}
+
+func TestParseWebhook_Action(t *testing.T) {
+	t.Parallel()
+
+	event, err := gosumsub.ParseWebhook([]byte(`{
+		"type": "applicantActionReviewed",
+		"applicantId": "app123",
+		"applicantActionId": "action-1",
+		"externalApplicantActionId": "ext-action-1",
+		"reviewResult": {"reviewAnswer": "GREEN"}
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	action, err := event.Action()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if action.ApplicantActionID != "action-1" || action.ExternalApplicantActionID != "ext-action-1" {
+		t.Errorf("unexpected action payload %+v", action)
+	}
+
+	if _, err := event.VideoIdent(); !errors.Is(err, gosumsub.ErrWebhookTypeMismatch) {
+		t.Errorf("expected ErrWebhookTypeMismatch, got %v", err)
+	}
+}
+
+func TestParseWebhook_VideoIdent(t *testing.T) {
+	t.Parallel()
+
+	event, err := gosumsub.ParseWebhook([]byte(`{
+		"type": "videoIdentStatusChanged",
+		"applicantId": "app123",
+		"videoIdentReviewStatus": "pending"
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	videoIdent, err := event.VideoIdent()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if videoIdent.VideoIdentReviewStatus != "pending" {
+		t.Errorf("unexpected video ident status %q", videoIdent.VideoIdentReviewStatus)
+	}
+}
+
+func TestParseWebhook_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		payload string
+		wantErr error
+	}{
+		{name: "empty payload", payload: "", wantErr: gosumsub.ErrEmptyPayload},
+		{name: "invalid JSON", payload: "{not json", wantErr: gosumsub.ErrMalformedWebhook},
+		{name: "missing type", payload: `{"applicantId":"app123"}`, wantErr: gosumsub.ErrMissingWebhookType},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := gosumsub.ParseWebhook([]byte(testCase.payload))
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("expected %v, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestWebhookEvent_CreatedAtMalformed(t *testing.T) {
+	t.Parallel()
+
+	event, err := gosumsub.ParseWebhook([]byte(`{"type":"applicantCreated","createdAtMs":"yesterday"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := event.CreatedAt(); !errors.Is(err, gosumsub.ErrMalformedWebhook) {
+		t.Errorf("expected ErrMalformedWebhook, got %v", err)
+	}
+}
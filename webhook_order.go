@@ -0,0 +1,138 @@
+package gosumsub
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const EchoContextKeyWebhookStale = "gosumsub.webhookStale"
+
+// StaleWebhookPolicy decides what happens to an event older than the last one seen for its applicant.
+type StaleWebhookPolicy int
+
+const (
+	// StaleWebhookDrop acknowledges stale events with 200 without handling them.
+	StaleWebhookDrop StaleWebhookPolicy = iota
+	// StaleWebhookFlag handles stale events with WebhookEvent.Stale set.
+	StaleWebhookFlag
+)
+
+// OrderStore tracks the creation time of the newest webhook event seen per applicant.
+// Implementations must be safe for concurrent use.
+type OrderStore interface {
+	// Advance records createdAt for applicantID unless a newer time is already recorded, and reports
+	// whether createdAt is older than the recorded time.
+	Advance(ctx context.Context, applicantID string, createdAt time.Time) (bool, error)
+}
+
+// MemoryOrderStore keeps the last-seen times in memory. It holds one entry per applicant.
+type MemoryOrderStore struct {
+	mu       sync.Mutex
+	lastSeen map[string]time.Time
+}
+
+func NewMemoryOrderStore() *MemoryOrderStore {
+	return &MemoryOrderStore{mu: sync.Mutex{}, lastSeen: make(map[string]time.Time)}
+}
+
+func (s *MemoryOrderStore) Advance(_ context.Context, applicantID string, createdAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if last, ok := s.lastSeen[applicantID]; ok && createdAt.Before(last) {
+		return true, nil
+	}
+
+	s.lastSeen[applicantID] = createdAt
+
+	return false, nil
+}
+
+// CheckWebhookOrder records event in store and sets event.Stale when a newer event for the same
+// applicant was seen before. Events without an applicant ID or creation time are never stale.
+func CheckWebhookOrder(ctx context.Context, store OrderStore, event *WebhookEvent) error {
+	createdAt, ok := webhookCreatedAt(event)
+	if event.ApplicantID == "" || !ok {
+		return nil
+	}
+
+	stale, err := store.Advance(ctx, event.ApplicantID, createdAt)
+	if err != nil {
+		return err
+	}
+
+	event.Stale = stale
+
+	return nil
+}
+
+func webhookCreatedAt(event *WebhookEvent) (time.Time, bool) {
+	createdAt, err := event.CreatedAt()
+
+	return createdAt, err == nil
+}
+
+// IsStaleWebhook reports whether WebhookOrderMiddleware flagged the event in ctx as stale.
+func IsStaleWebhook(ctx context.Context) bool {
+	event, ok := WebhookEventFromContext(ctx)
+
+	return ok && event.Stale
+}
+
+// WebhookOrderMiddleware guards against events delivered out of order. It must run after
+// WebhookMiddleware. With StaleWebhookFlag, next can check IsStaleWebhook.
+func WebhookOrderMiddleware(store OrderStore, policy StaleWebhookPolicy) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			event, ok := webhookEventFromRequest(request)
+			if !ok {
+				next.ServeHTTP(writer, request)
+
+				return
+			}
+
+			if err := CheckWebhookOrder(request.Context(), store, event); err != nil {
+				http.Error(writer, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+				return
+			}
+
+			if event.Stale && policy == StaleWebhookDrop {
+				writer.WriteHeader(http.StatusOK)
+
+				return
+			}
+
+			next.ServeHTTP(writer, request.WithContext(withWebhookEvent(request.Context(), event)))
+		})
+	}
+}
+
+func EchoWebhookOrderMiddleware(store OrderStore, policy StaleWebhookPolicy) func(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			event, ok := webhookEventFromRequest(ctx.Request())
+			if !ok {
+				return next(ctx)
+			}
+
+			if err := CheckWebhookOrder(ctx.Request().Context(), store, event); err != nil {
+				return ctx.String(http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+			}
+
+			if event.Stale && policy == StaleWebhookDrop {
+				return ctx.NoContent(http.StatusOK)
+			}
+
+			ctx.SetRequest(ctx.Request().WithContext(withWebhookEvent(ctx.Request().Context(), event)))
+			ctx.Set(EchoContextKeyWebhookEvent, event)
+			ctx.Set(EchoContextKeyWebhookStale, event.Stale)
+
+			return next(ctx)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package gosumsub_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/andyle182810/gosumsub"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	testPendingWebhookBody = `{"type":"applicantPending","applicantId":"app123","correlationId":"c1",` +
+		`"createdAtMs":"2024-03-05 10:00:00.000"}`
+	testLaterReviewedWebhookBody = `{"type":"applicantReviewed","applicantId":"app123","correlationId":"c2",` +
+		`"createdAtMs":"2024-03-05 11:00:00.000"}`
+)
+
+func TestMemoryOrderStore_Advance(t *testing.T) {
+	t.Parallel()
+
+	store := gosumsub.NewMemoryOrderStore()
+	ctx := t.Context()
+	base := time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC)
+
+	steps := []struct {
+		applicantID string
+		createdAt   time.Time
+		wantStale   bool
+	}{
+		{applicantID: "app1", createdAt: base.Add(time.Hour), wantStale: false},
+		{applicantID: "app1", createdAt: base, wantStale: true},
+		{applicantID: "app1", createdAt: base.Add(time.Hour), wantStale: false},
+		{applicantID: "app2", createdAt: base, wantStale: false},
+	}
+
+	for i, step := range steps {
+		stale, err := store.Advance(ctx, step.applicantID, step.createdAt)
+		if err != nil {
+			t.Fatalf("step %d: unexpected error: %v", i, err)
+		}
+
+		if stale != step.wantStale {
+			t.Errorf("step %d: expected stale=%v, got %v", i, step.wantStale, stale)
+		}
+	}
+}
+
+func TestCheckWebhookOrder_IgnoresEventsWithoutTime(t *testing.T) {
+	t.Parallel()
+
+	store := gosumsub.NewMemoryOrderStore()
+
+	event, err := gosumsub.ParseWebhook([]byte(`{"type":"applicantCreated","applicantId":"app123"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := gosumsub.CheckWebhookOrder(t.Context(), store, event); err != nil || event.Stale {
+		t.Errorf("expected event without createdAtMs to pass, got stale=%v err=%v", event.Stale, err)
+	}
+}
+
+func TestWebhookOrderMiddleware_DropsStale(t *testing.T) {
+	t.Parallel()
+
+	var handled []string
+
+	handler := gosumsub.WebhookMiddleware(testSecretKey)(
+		gosumsub.WebhookOrderMiddleware(gosumsub.NewMemoryOrderStore(), gosumsub.StaleWebhookDrop)(
+			http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				body, _ := io.ReadAll(request.Body)
+
+				event, err := gosumsub.ParseWebhook(body)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+
+					return
+				}
+
+				handled = append(handled, event.Type)
+
+				writer.WriteHeader(http.StatusOK)
+			}),
+		),
+	)
+
+	for _, body := range []string{testLaterReviewedWebhookBody, testPendingWebhookBody} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newSignedWebhookRequest(body, testSecretKey))
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+	}
+
+	if len(handled) != 1 || handled[0] != gosumsub.WebhookTypeApplicantReviewed {
+		t.Errorf("expected only applicantReviewed to be handled, got %v", handled)
+	}
+}
+
+func TestWebhookOrderMiddleware_FlagsStale(t *testing.T) {
+	t.Parallel()
+
+	var staleFlags []bool
+
+	handler := gosumsub.WebhookOrderMiddleware(gosumsub.NewMemoryOrderStore(), gosumsub.StaleWebhookFlag)(
+		http.HandlerFunc(func(_ http.ResponseWriter, request *http.Request) {
+			staleFlags = append(staleFlags, gosumsub.IsStaleWebhook(request.Context()))
+		}),
+	)
+
+	for _, body := range []string{testLaterReviewedWebhookBody, testPendingWebhookBody} {
+		handler.ServeHTTP(httptest.NewRecorder(), newSignedWebhookRequest(body, testSecretKey))
+	}
+
+	if len(staleFlags) != 2 || staleFlags[0] || !staleFlags[1] {
+		t.Errorf("expected [false true], got %v", staleFlags)
+	}
+}
+
+func TestEchoWebhookOrderMiddleware_FlagsStale(t *testing.T) {
+	t.Parallel()
+
+	var staleFlags []bool
+
+	handler := gosumsub.EchoWebhookOrderMiddleware(gosumsub.NewMemoryOrderStore(), gosumsub.StaleWebhookFlag)(
+		func(ctx echo.Context) error {
+			stale, _ := ctx.Get(gosumsub.EchoContextKeyWebhookStale).(bool)
+			staleFlags = append(staleFlags, stale)
+
+			return ctx.NoContent(http.StatusOK)
+		},
+	)
+	echoInstance := echo.New()
+
+	for _, body := range []string{testLaterReviewedWebhookBody, testPendingWebhookBody} {
+		req := newSignedWebhookRequest(body, testEchoSecretKey)
+
+		if err := handler(echoInstance.NewContext(req, httptest.NewRecorder())); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if len(staleFlags) != 2 || staleFlags[0] || !staleFlags[1] {
+		t.Errorf("expected [false true], got %v", staleFlags)
+	}
+}
+
+func TestWebhookRouter_WithOrderGuard(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		policy      gosumsub.StaleWebhookPolicy
+		wantPending []bool
+	}{
+		{name: "drop", policy: gosumsub.StaleWebhookDrop, wantPending: nil},
+		{name: "flag", policy: gosumsub.StaleWebhookFlag, wantPending: []bool{true}},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := gosumsub.NewWebhookRouter(
+				testSecretKey,
+				gosumsub.WithWebhookOrderGuard(gosumsub.NewMemoryOrderStore(), testCase.policy),
+			)
+
+			var pending []bool
+
+			router.OnApplicantPending(func(_ context.Context, event *gosumsub.ApplicantPendingEvent) error {
+				pending = append(pending, event.Stale)
+
+				return nil
+			})
+
+			for _, body := range []string{testLaterReviewedWebhookBody, testPendingWebhookBody} {
+				rec := httptest.NewRecorder()
+				router.ServeHTTP(rec, newSignedWebhookRequest(body, testSecretKey))
+
+				if rec.Code != http.StatusOK {
+					t.Errorf("expected status %d, got %d", http.StatusOK, rec.Code)
+				}
+			}
+
+			if len(pending) != len(testCase.wantPending) || (len(pending) == 1 && !pending[0]) {
+				t.Errorf("expected pending handler calls %v, got %v", testCase.wantPending, pending)
+			}
+		})
+	}
+}
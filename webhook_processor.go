@@ -0,0 +1,332 @@
+package gosumsub
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultWebhookWorkers      = 4
+	defaultWebhookMaxAttempts  = 5
+	defaultWebhookRetryBackoff = time.Second
+	defaultWebhookMaxBackoff   = time.Minute
+	webhookBackoffFactor       = 2
+)
+
+// DeadLetterSink receives webhooks that could not be processed, together with the last error.
+type DeadLetterSink interface {
+	DeadLetter(ctx context.Context, webhook *QueuedWebhook, err error) error
+}
+
+type DeadLetterFunc func(ctx context.Context, webhook *QueuedWebhook, err error) error
+
+func (f DeadLetterFunc) DeadLetter(ctx context.Context, webhook *QueuedWebhook, err error) error {
+	return f(ctx, webhook, err)
+}
+
+type WebhookProcessorOption func(*WebhookProcessor)
+
+// WebhookProcessor takes webhooks off a WebhookQueue and runs handler for each of them on a pool of
+// workers. Failed attempts are retried with exponential backoff; webhooks that still fail, or whose
+// payload cannot be parsed, go to the dead-letter sink.
+type WebhookProcessor struct {
+	queue       WebhookQueue
+	handler     WebhookHandlerFunc
+	workers     int
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	deadLetter  DeadLetterSink
+	logger      Logger
+}
+
+// NewWebhookProcessor creates a processor. Pass WebhookRouter.Handle as handler so that the router's
+// deduplication and ordering guard apply; WebhookRouter.Dispatch skips them.
+func NewWebhookProcessor(
+	queue WebhookQueue,
+	handler WebhookHandlerFunc,
+	opts ...WebhookProcessorOption,
+) *WebhookProcessor {
+	processor := &WebhookProcessor{
+		queue:       queue,
+		handler:     handler,
+		workers:     defaultWebhookWorkers,
+		maxAttempts: defaultWebhookMaxAttempts,
+		backoff:     defaultWebhookRetryBackoff,
+		maxBackoff:  defaultWebhookMaxBackoff,
+		deadLetter:  nil,
+		logger:      slog.Default(),
+	}
+
+	for _, opt := range opts {
+		opt(processor)
+	}
+
+	return processor
+}
+
+func WithWebhookWorkers(workers int) WebhookProcessorOption {
+	return func(p *WebhookProcessor) {
+		if workers > 0 {
+			p.workers = workers
+		}
+	}
+}
+
+// WithWebhookRetry sets how many times a webhook is attempted in total, and the backoff before the
+// second attempt, which doubles on each further attempt up to maxBackoff.
+func WithWebhookRetry(maxAttempts int, backoff, maxBackoff time.Duration) WebhookProcessorOption {
+	return func(p *WebhookProcessor) {
+		if maxAttempts > 0 {
+			p.maxAttempts = maxAttempts
+		}
+
+		if backoff >= 0 {
+			p.backoff = backoff
+		}
+
+		if maxBackoff >= backoff {
+			p.maxBackoff = maxBackoff
+		}
+	}
+}
+
+// WithDeadLetterSink sets where failed webhooks go. Without one, they are logged and dropped.
+// A failing sink is retried with backoff until it succeeds or Run's context is done; the handler is
+// not run again meanwhile. A webhook still waiting for the sink at shutdown stays unacknowledged,
+// so a FileWebhookQueue delivers it again after a restart and a MemoryWebhookQueue loses it.
+func WithDeadLetterSink(sink DeadLetterSink) WebhookProcessorOption {
+	return func(p *WebhookProcessor) {
+		p.deadLetter = sink
+	}
+}
+
+func WithWebhookProcessorLogger(logger Logger) WebhookProcessorOption {
+	return func(p *WebhookProcessor) {
+		if logger != nil {
+			p.logger = logger
+		}
+	}
+}
+
+// Run processes webhooks until ctx is done or the queue is closed, then waits for the workers to
+// finish. A webhook being retried when ctx is done is left unacknowledged. Dequeue errors other than
+// ErrWebhookQueueClosed are logged and retried with the same backoff as failed handlers.
+func (p *WebhookProcessor) Run(ctx context.Context) error {
+	var waitGroup sync.WaitGroup
+
+	for range p.workers {
+		waitGroup.Go(func() {
+			p.work(ctx)
+		})
+	}
+
+	waitGroup.Wait()
+
+	return ctx.Err()
+}
+
+func (p *WebhookProcessor) work(ctx context.Context) {
+	backoff := p.backoff
+
+	for {
+		webhook, err := p.queue.Dequeue(ctx)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, ErrWebhookQueueClosed) {
+				return
+			}
+
+			p.logger.Error("failed to dequeue webhook", "error", err)
+
+			if !sleepContext(ctx, backoff) {
+				return
+			}
+
+			backoff = min(backoff*webhookBackoffFactor, p.maxBackoff)
+
+			continue
+		}
+
+		backoff = p.backoff
+
+		if !p.process(ctx, webhook) {
+			return
+		}
+	}
+}
+
+// process handles one webhook and reports whether the worker should keep going.
+func (p *WebhookProcessor) process(ctx context.Context, webhook *QueuedWebhook) bool {
+	eventCtx, event, err := withWebhook(ctx, webhook.Payload)
+	if err == nil {
+		err = p.attempt(eventCtx, event)
+	}
+
+	if err != nil && ctx.Err() != nil {
+		return false
+	}
+
+	// The handler is not run again for a failed webhook: only the dead-letter sink is retried, and
+	// the webhook stays unacknowledged if ctx is done first.
+	if err != nil && p.sendToDeadLetter(ctx, webhook, err) != nil {
+		return false
+	}
+
+	if err := p.queue.Ack(ctx, webhook); err != nil {
+		p.logger.Error("failed to ack webhook", "id", webhook.ID, "error", err)
+	}
+
+	return true
+}
+
+func (p *WebhookProcessor) attempt(ctx context.Context, event *WebhookEvent) error {
+	return p.retry(ctx, p.maxAttempts, func() error {
+		return p.handler(ctx, event)
+	}, func(attempt int, err error) {
+		p.logger.Info("webhook handler failed, retrying", "type", event.Type, "attempt", attempt, "error", err)
+	})
+}
+
+// retry calls op up to maxAttempts times, or until ctx is done when maxAttempts is zero, with
+// exponential backoff between attempts.
+func (p *WebhookProcessor) retry(
+	ctx context.Context,
+	maxAttempts int,
+	op func() error,
+	onRetry func(attempt int, err error),
+) error {
+	backoff := p.backoff
+
+	for attempt := 1; ; attempt++ {
+		err := op()
+		if err == nil {
+			return nil
+		}
+
+		if attempt == maxAttempts {
+			return err
+		}
+
+		onRetry(attempt, err)
+
+		if !sleepContext(ctx, backoff) {
+			return ctx.Err()
+		}
+
+		backoff = min(backoff*webhookBackoffFactor, p.maxBackoff)
+	}
+}
+
+// sendToDeadLetter retries the sink until it accepts the webhook or ctx is done.
+func (p *WebhookProcessor) sendToDeadLetter(ctx context.Context, webhook *QueuedWebhook, err error) error {
+	if p.deadLetter == nil {
+		p.logger.Error("dropping failed webhook", "id", webhook.ID, "error", err)
+
+		return nil
+	}
+
+	return p.retry(ctx, 0, func() error {
+		return p.deadLetter.DeadLetter(ctx, webhook, err)
+	}, func(attempt int, deadLetterErr error) {
+		p.logger.Error("dead-letter sink failed, retrying", "id", webhook.ID, "attempt", attempt, "error", deadLetterErr)
+	})
+}
+
+// sleepContext waits for d and reports whether ctx is still active afterwards.
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// AsyncWebhookHandler verifies webhooks, stores them in queue and acknowledges them right away, so
+// slow processing does not make Sumsub time out and redeliver. It responds 500 when the webhook
+// cannot be queued, so that Sumsub delivers it again.
+func AsyncWebhookHandler(secretKey string, queue WebhookQueue) http.Handler {
+	var options WebhookMiddlewareOptions
+
+	return AsyncWebhookHandlerWithOptions(secretKey, queue, options)
+}
+
+// AsyncWebhookHandlerWithOptions is AsyncWebhookHandler with the verification configured by options,
+// for example a SecretProvider that accepts the old and new secret during rotation.
+func AsyncWebhookHandlerWithOptions(secretKey string, queue WebhookQueue, options WebhookMiddlewareOptions) http.Handler {
+	verifier := NewWebhookVerifier(secretKey, options)
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, err := verifier.Verify(request)
+		if err != nil {
+			verifier.WriteError(writer, request, err)
+
+			return
+		}
+
+		status := enqueueWebhook(request.Context(), body, queue)
+		if status != http.StatusOK {
+			http.Error(writer, http.StatusText(status), status)
+
+			return
+		}
+
+		writer.WriteHeader(http.StatusOK)
+	})
+}
+
+func EchoAsyncWebhookHandler(secretKey string, queue WebhookQueue) echo.HandlerFunc {
+	var options WebhookMiddlewareOptions
+
+	return EchoAsyncWebhookHandlerWithOptions(secretKey, queue, options)
+}
+
+func EchoAsyncWebhookHandlerWithOptions(
+	secretKey string,
+	queue WebhookQueue,
+	options WebhookMiddlewareOptions,
+) echo.HandlerFunc {
+	verifier := NewWebhookVerifier(secretKey, options)
+
+	return func(ctx echo.Context) error {
+		body, err := verifier.Verify(ctx.Request())
+		if err != nil {
+			verifier.WriteError(ctx.Response(), ctx.Request(), err)
+
+			return nil
+		}
+
+		status := enqueueWebhook(ctx.Request().Context(), body, queue)
+		if status != http.StatusOK {
+			return ctx.String(status, http.StatusText(status))
+		}
+
+		return ctx.NoContent(http.StatusOK)
+	}
+}
+
+func enqueueWebhook(ctx context.Context, body []byte, queue WebhookQueue) int {
+	if _, err := ParseWebhook(body); err != nil {
+		return http.StatusBadRequest
+	}
+
+	webhook, err := newQueuedWebhook(body, time.Now())
+	if err != nil {
+		return http.StatusInternalServerError
+	}
+
+	if err := queue.Enqueue(ctx, webhook); err != nil {
+		return http.StatusInternalServerError
+	}
+
+	return http.StatusOK
+}
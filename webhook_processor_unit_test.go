@@ -0,0 +1,451 @@
+package gosumsub_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/andyle182810/gosumsub"
+	"github.com/labstack/echo/v4"
+)
+
+var errSinkUnavailable = errors.New("sink unavailable")
+
+type deadLetterRecorder struct {
+	mu       sync.Mutex
+	webhooks []*gosumsub.QueuedWebhook
+	errs     []error
+	done     chan struct{}
+}
+
+func newDeadLetterRecorder() *deadLetterRecorder {
+	return &deadLetterRecorder{mu: sync.Mutex{}, webhooks: nil, errs: nil, done: make(chan struct{}, 10)}
+}
+
+func (r *deadLetterRecorder) DeadLetter(_ context.Context, webhook *gosumsub.QueuedWebhook, err error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.webhooks = append(r.webhooks, webhook)
+	r.errs = append(r.errs, err)
+	r.done <- struct{}{}
+
+	return nil
+}
+
+func runProcessor(t *testing.T, processor *gosumsub.WebhookProcessor) context.CancelFunc {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	stopped := make(chan struct{})
+
+	go func() {
+		_ = processor.Run(ctx)
+
+		close(stopped)
+	}()
+
+	return func() {
+		cancel()
+		<-stopped
+	}
+}
+
+func waitFor(t *testing.T, signal <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-signal:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for webhook processing")
+	}
+}
+
+func TestAsyncWebhookHandler_Enqueues(t *testing.T) {
+	t.Parallel()
+
+	queue := gosumsub.NewMemoryWebhookQueue(1)
+	handler := gosumsub.AsyncWebhookHandler(testSecretKey, queue)
+
+	tests := []struct {
+		name       string
+		request    *http.Request
+		wantStatus int
+	}{
+		{
+			name:       "invalid signature",
+			request:    newSignedWebhookRequest(testReviewedWebhookBody, "wrong"),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "not a webhook event",
+			request:    newSignedWebhookRequest(`{"test":"data"}`, testSecretKey),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "queued",
+			request:    newSignedWebhookRequest(testReviewedWebhookBody, testSecretKey),
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "queue full",
+			request:    newSignedWebhookRequest(testReviewedWebhookBody, testSecretKey),
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range tests {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, testCase.request)
+
+		if rec.Code != testCase.wantStatus {
+			t.Errorf("%s: expected status %d, got %d", testCase.name, testCase.wantStatus, rec.Code)
+		}
+	}
+
+	webhook, err := queue.Dequeue(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(webhook.Payload) != testReviewedWebhookBody || webhook.ID == "" {
+		t.Errorf("unexpected queued webhook %+v", webhook)
+	}
+}
+
+func TestEchoAsyncWebhookHandler_Enqueues(t *testing.T) {
+	t.Parallel()
+
+	queue := gosumsub.NewMemoryWebhookQueue(0)
+	handler := gosumsub.EchoAsyncWebhookHandler(testEchoSecretKey, queue)
+
+	req := newSignedWebhookRequest(testReviewedWebhookBody, testEchoSecretKey)
+	rec := httptest.NewRecorder()
+
+	if err := handler(echo.New().NewContext(req, rec)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+
+	if _, err := queue.Dequeue(t.Context()); err != nil {
+		t.Errorf("expected a queued webhook, got %v", err)
+	}
+}
+
+func TestAsyncWebhookHandlerWithOptions(t *testing.T) {
+	t.Parallel()
+
+	queue := gosumsub.NewMemoryWebhookQueue(0)
+
+	var gotErr error
+
+	var options gosumsub.WebhookMiddlewareOptions
+
+	options.SecretProvider = gosumsub.StaticSecrets{"new-secret", "old-secret"}
+	options.MaxBodyBytes = int64(len(testReviewedWebhookBody))
+	options.OnError = func(_ *http.Request, err error) { gotErr = err }
+
+	handler := gosumsub.AsyncWebhookHandlerWithOptions("", queue, options)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newSignedWebhookRequest(testReviewedWebhookBody, "old-secret"))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d for the previous secret, got %d", http.StatusOK, rec.Code)
+	}
+
+	if _, err := queue.Dequeue(t.Context()); err != nil {
+		t.Errorf("expected a queued webhook, got %v", err)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, newSignedWebhookRequest(testReviewedWebhookBody+" ", "new-secret"))
+
+	if rec.Code != http.StatusRequestEntityTooLarge || !errors.Is(gotErr, gosumsub.ErrBodyTooLarge) {
+		t.Errorf("expected status %d with ErrBodyTooLarge, got %d and %v", http.StatusRequestEntityTooLarge, rec.Code, gotErr)
+	}
+}
+
+func TestEchoAsyncWebhookHandlerWithOptions(t *testing.T) {
+	t.Parallel()
+
+	queue := gosumsub.NewMemoryWebhookQueue(0)
+
+	var options gosumsub.WebhookMiddlewareOptions
+
+	options.SecretProvider = gosumsub.StaticSecrets{"new-secret", "old-secret"}
+
+	handler := gosumsub.EchoAsyncWebhookHandlerWithOptions("", queue, options)
+
+	rec := httptest.NewRecorder()
+	req := newSignedWebhookRequest(testReviewedWebhookBody, "old-secret")
+
+	if err := handler(echo.New().NewContext(req, rec)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d for the previous secret, got %d", http.StatusOK, rec.Code)
+	}
+
+	if _, err := queue.Dequeue(t.Context()); err != nil {
+		t.Errorf("expected a queued webhook, got %v", err)
+	}
+}
+
+func TestWebhookProcessor_RetriesUntilSuccess(t *testing.T) {
+	t.Parallel()
+
+	queue := gosumsub.NewMemoryWebhookQueue(0)
+	router := gosumsub.NewWebhookRouter(testSecretKey)
+	handled := make(chan struct{}, 1)
+
+	var attempts atomic.Int32
+
+	router.OnApplicantReviewed(func(context.Context, *gosumsub.ApplicantReviewedEvent) error {
+		if attempts.Add(1) < 3 {
+			return errHandlerFailed
+		}
+
+		handled <- struct{}{}
+
+		return nil
+	})
+
+	deadLetters := newDeadLetterRecorder()
+	stop := runProcessor(t, gosumsub.NewWebhookProcessor(
+		queue,
+		router.Handle,
+		gosumsub.WithWebhookWorkers(2),
+		gosumsub.WithWebhookRetry(3, time.Millisecond, 5*time.Millisecond),
+		gosumsub.WithDeadLetterSink(deadLetters),
+		gosumsub.WithWebhookProcessorLogger(slog.New(slog.DiscardHandler)),
+	))
+	defer stop()
+
+	_ = queue.Enqueue(t.Context(), newTestQueuedWebhook("w1", testReviewedWebhookBody))
+
+	waitFor(t, handled)
+
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("expected 3 attempts, got %d", got)
+	}
+
+	deadLetters.mu.Lock()
+	defer deadLetters.mu.Unlock()
+
+	if len(deadLetters.webhooks) != 0 {
+		t.Errorf("expected no dead letters, got %d", len(deadLetters.webhooks))
+	}
+}
+
+func TestWebhookProcessor_RouterHandleDeduplicates(t *testing.T) {
+	t.Parallel()
+
+	queue := gosumsub.NewMemoryWebhookQueue(0)
+	router := gosumsub.NewWebhookRouter(testSecretKey, gosumsub.WithWebhookDedup(gosumsub.NewMemoryDedupStore(0)))
+	handled := make(chan struct{}, 2)
+
+	var calls atomic.Int32
+
+	router.OnApplicantReviewed(func(context.Context, *gosumsub.ApplicantReviewedEvent) error {
+		calls.Add(1)
+		handled <- struct{}{}
+
+		return nil
+	})
+
+	stop := runProcessor(t, gosumsub.NewWebhookProcessor(
+		queue,
+		router.Handle,
+		gosumsub.WithWebhookWorkers(1),
+		gosumsub.WithWebhookProcessorLogger(slog.New(slog.DiscardHandler)),
+	))
+	defer stop()
+
+	_ = queue.Enqueue(t.Context(), newTestQueuedWebhook("w1", testReviewedWebhookBody))
+	_ = queue.Enqueue(t.Context(), newTestQueuedWebhook("w2", testReviewedWebhookBody))
+
+	waitFor(t, handled)
+
+	select {
+	case <-handled:
+		t.Error("expected the redelivered webhook to be skipped")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected 1 call, got %d", got)
+	}
+}
+
+func TestWebhookProcessor_DeadLetters(t *testing.T) {
+	t.Parallel()
+
+	queue := gosumsub.NewMemoryWebhookQueue(0)
+	deadLetters := newDeadLetterRecorder()
+
+	var attempts atomic.Int32
+
+	stop := runProcessor(t, gosumsub.NewWebhookProcessor(
+		queue,
+		func(context.Context, *gosumsub.WebhookEvent) error {
+			attempts.Add(1)
+
+			return errHandlerFailed
+		},
+		gosumsub.WithWebhookRetry(2, time.Millisecond, time.Millisecond),
+		gosumsub.WithDeadLetterSink(deadLetters),
+		gosumsub.WithWebhookProcessorLogger(slog.New(slog.DiscardHandler)),
+	))
+	defer stop()
+
+	_ = queue.Enqueue(t.Context(), newTestQueuedWebhook("w1", testReviewedWebhookBody))
+	_ = queue.Enqueue(t.Context(), newTestQueuedWebhook("w2", "not json"))
+
+	waitFor(t, deadLetters.done)
+	waitFor(t, deadLetters.done)
+
+	deadLetters.mu.Lock()
+	defer deadLetters.mu.Unlock()
+
+	for i, webhook := range deadLetters.webhooks {
+		wantErr := errHandlerFailed
+		if webhook.ID == "w2" {
+			wantErr = gosumsub.ErrMalformedWebhook
+		}
+
+		if !errors.Is(deadLetters.errs[i], wantErr) {
+			t.Errorf("%s: expected %v, got %v", webhook.ID, wantErr, deadLetters.errs[i])
+		}
+	}
+
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("expected 2 attempts for the failing webhook, got %d", got)
+	}
+}
+
+func TestWebhookProcessor_RetriesDeadLetterSink(t *testing.T) {
+	t.Parallel()
+
+	queue := gosumsub.NewMemoryWebhookQueue(0)
+	deadLetters := newDeadLetterRecorder()
+
+	var attempts, sinkCalls atomic.Int32
+
+	stop := runProcessor(t, gosumsub.NewWebhookProcessor(
+		queue,
+		func(context.Context, *gosumsub.WebhookEvent) error {
+			attempts.Add(1)
+
+			return errHandlerFailed
+		},
+		gosumsub.WithWebhookWorkers(1),
+		gosumsub.WithWebhookRetry(2, time.Millisecond, time.Millisecond),
+		gosumsub.WithDeadLetterSink(gosumsub.DeadLetterFunc(
+			func(ctx context.Context, webhook *gosumsub.QueuedWebhook, err error) error {
+				if sinkCalls.Add(1) <= 2 {
+					return errSinkUnavailable
+				}
+
+				return deadLetters.DeadLetter(ctx, webhook, err)
+			},
+		)),
+		gosumsub.WithWebhookProcessorLogger(slog.New(slog.DiscardHandler)),
+	))
+	defer stop()
+
+	_ = queue.Enqueue(t.Context(), newTestQueuedWebhook("w1", testReviewedWebhookBody))
+
+	waitFor(t, deadLetters.done)
+
+	deadLetters.mu.Lock()
+	defer deadLetters.mu.Unlock()
+
+	if len(deadLetters.webhooks) != 1 || deadLetters.webhooks[0].ID != "w1" {
+		t.Fatalf("expected the webhook to be dead-lettered once, got %d", len(deadLetters.webhooks))
+	}
+
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("expected the handler not to run again while the sink fails, got %d attempts", got)
+	}
+
+	if got := sinkCalls.Load(); got != 3 {
+		t.Errorf("expected 3 sink calls, got %d", got)
+	}
+}
+
+// failingQueue is a WebhookQueue whose Dequeue always fails.
+type failingQueue struct {
+	dequeues atomic.Int32
+}
+
+func (q *failingQueue) Enqueue(context.Context, *gosumsub.QueuedWebhook) error {
+	return nil
+}
+
+func (q *failingQueue) Dequeue(context.Context) (*gosumsub.QueuedWebhook, error) {
+	q.dequeues.Add(1)
+
+	return nil, errSinkUnavailable
+}
+
+func (q *failingQueue) Ack(context.Context, *gosumsub.QueuedWebhook) error {
+	return nil
+}
+
+func TestWebhookProcessor_BacksOffOnDequeueErrors(t *testing.T) {
+	t.Parallel()
+
+	queue := &failingQueue{dequeues: atomic.Int32{}}
+
+	stop := runProcessor(t, gosumsub.NewWebhookProcessor(
+		queue,
+		func(context.Context, *gosumsub.WebhookEvent) error { return nil },
+		gosumsub.WithWebhookWorkers(1),
+		gosumsub.WithWebhookRetry(1, 20*time.Millisecond, time.Second),
+		gosumsub.WithWebhookProcessorLogger(slog.New(slog.DiscardHandler)),
+	))
+
+	time.Sleep(100 * time.Millisecond)
+	stop()
+
+	if got := queue.dequeues.Load(); got > 5 {
+		t.Errorf("expected dequeue retries to back off, got %d calls in 100ms", got)
+	}
+}
+
+func TestWebhookProcessor_StopsWhenQueueCloses(t *testing.T) {
+	t.Parallel()
+
+	queue := gosumsub.NewMemoryWebhookQueue(0)
+	processor := gosumsub.NewWebhookProcessor(queue, func(context.Context, *gosumsub.WebhookEvent) error {
+		return nil
+	})
+
+	done := make(chan error)
+
+	go func() {
+		done <- processor.Run(context.Background())
+	}()
+
+	_ = queue.Close()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("expected nil error, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("processor did not stop after the queue closed")
+	}
+}
@@ -0,0 +1,426 @@
+package gosumsub
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	defaultWebhookQueueSize = 1000
+	webhookQueueFilePerm    = 0o600
+	webhookQueueIDLen       = 16
+	// The log is compacted once it holds at least webhookLogCompactMin records and more than
+	// webhookLogCompactFactor times as many as there are unacknowledged webhooks.
+	webhookLogCompactMin    = 1024
+	webhookLogCompactFactor = 2
+)
+
+var (
+	ErrWebhookQueueFull   = errors.New("webhook queue is full")
+	ErrWebhookQueueClosed = errors.New("webhook queue is closed")
+)
+
+// QueuedWebhook is a verified webhook payload waiting to be processed.
+type QueuedWebhook struct {
+	ID         string    `json:"id"`
+	Payload    []byte    `json:"payload"`
+	ReceivedAt time.Time `json:"receivedAt"`
+}
+
+// WebhookQueue buffers verified webhooks between the HTTP handler that acknowledges them and the
+// workers that process them. Implementations must be safe for concurrent use.
+type WebhookQueue interface {
+	Enqueue(ctx context.Context, webhook *QueuedWebhook) error
+	// Dequeue blocks until a webhook is available, ctx is done or the queue is closed.
+	Dequeue(ctx context.Context) (*QueuedWebhook, error)
+	// Ack marks a dequeued webhook as done, whether it was handled or dead-lettered.
+	Ack(ctx context.Context, webhook *QueuedWebhook) error
+}
+
+func newQueuedWebhook(payload []byte, receivedAt time.Time) (*QueuedWebhook, error) {
+	id := make([]byte, webhookQueueIDLen)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	return &QueuedWebhook{ID: hex.EncodeToString(id), Payload: payload, ReceivedAt: receivedAt}, nil
+}
+
+// MemoryWebhookQueue is a bounded in-process queue. Webhooks still queued when the process exits
+// are lost; Sumsub does not redeliver them because they were already acknowledged.
+type MemoryWebhookQueue struct {
+	webhooks chan *QueuedWebhook
+	closed   chan struct{}
+	once     sync.Once
+}
+
+// NewMemoryWebhookQueue creates a queue holding up to size webhooks, or 1000 when size is not
+// positive. Enqueue fails with ErrWebhookQueueFull instead of blocking.
+func NewMemoryWebhookQueue(size int) *MemoryWebhookQueue {
+	if size <= 0 {
+		size = defaultWebhookQueueSize
+	}
+
+	return &MemoryWebhookQueue{
+		webhooks: make(chan *QueuedWebhook, size),
+		closed:   make(chan struct{}),
+		once:     sync.Once{},
+	}
+}
+
+func (q *MemoryWebhookQueue) Enqueue(_ context.Context, webhook *QueuedWebhook) error {
+	select {
+	case <-q.closed:
+		return ErrWebhookQueueClosed
+	default:
+	}
+
+	select {
+	case q.webhooks <- webhook:
+		return nil
+	default:
+		return ErrWebhookQueueFull
+	}
+}
+
+func (q *MemoryWebhookQueue) Dequeue(ctx context.Context) (*QueuedWebhook, error) {
+	select {
+	case webhook := <-q.webhooks:
+		return webhook, nil
+	case <-q.closed:
+		return nil, ErrWebhookQueueClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (q *MemoryWebhookQueue) Ack(context.Context, *QueuedWebhook) error {
+	return nil
+}
+
+// Close stops Enqueue and wakes up blocked Dequeue calls. Webhooks still in the queue are dropped.
+func (q *MemoryWebhookQueue) Close() error {
+	q.once.Do(func() { close(q.closed) })
+
+	return nil
+}
+
+type webhookLogRecord struct {
+	Op      string         `json:"op"`
+	Webhook *QueuedWebhook `json:"webhook,omitempty"`
+	ID      string         `json:"id,omitempty"`
+}
+
+const (
+	webhookLogOpEnqueue = "enqueue"
+	webhookLogOpAck     = "ack"
+)
+
+// FileWebhookQueue persists webhooks in an append-only log file. Each enqueue is synced to disk
+// before it returns, and webhooks that were not acknowledged are queued again when the file is
+// reopened, so processing resumes after a restart. The log is compacted on open and, while the
+// queue runs, whenever acknowledged webhooks make up most of it.
+type FileWebhookQueue struct {
+	mu       sync.Mutex
+	path     string
+	file     *os.File
+	size     int64
+	records  int
+	pending  []*QueuedWebhook
+	inflight map[string]*QueuedWebhook
+	notify   chan struct{}
+	closed   bool
+}
+
+// OpenFileWebhookQueue opens or creates the log at path. The log is compacted on open so that it
+// only contains webhooks that still need processing.
+func OpenFileWebhookQueue(path string) (*FileWebhookQueue, error) {
+	pending, err := readWebhookLog(path)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := &FileWebhookQueue{
+		mu:       sync.Mutex{},
+		path:     path,
+		file:     nil,
+		size:     0,
+		records:  0,
+		pending:  pending,
+		inflight: make(map[string]*QueuedWebhook),
+		notify:   make(chan struct{}),
+		closed:   false,
+	}
+
+	if err := queue.compact(); err != nil {
+		return nil, err
+	}
+
+	return queue, nil
+}
+
+func (q *FileWebhookQueue) Enqueue(_ context.Context, webhook *QueuedWebhook) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return ErrWebhookQueueClosed
+	}
+
+	if err := q.append(webhookLogRecord{Op: webhookLogOpEnqueue, Webhook: webhook, ID: ""}, true); err != nil {
+		return err
+	}
+
+	q.pending = append(q.pending, webhook)
+	q.wake()
+
+	return nil
+}
+
+func (q *FileWebhookQueue) Dequeue(ctx context.Context) (*QueuedWebhook, error) {
+	for {
+		q.mu.Lock()
+
+		if q.closed {
+			q.mu.Unlock()
+
+			return nil, ErrWebhookQueueClosed
+		}
+
+		if len(q.pending) > 0 {
+			webhook := q.pending[0]
+			q.pending = q.pending[1:]
+			q.inflight[webhook.ID] = webhook
+			q.mu.Unlock()
+
+			return webhook, nil
+		}
+
+		notify := q.notify
+		q.mu.Unlock()
+
+		select {
+		case <-notify:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (q *FileWebhookQueue) Ack(_ context.Context, webhook *QueuedWebhook) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return ErrWebhookQueueClosed
+	}
+
+	if err := q.append(webhookLogRecord{Op: webhookLogOpAck, Webhook: nil, ID: webhook.ID}, false); err != nil {
+		return err
+	}
+
+	delete(q.inflight, webhook.ID)
+
+	live := len(q.pending) + len(q.inflight)
+	if q.records >= webhookLogCompactMin && q.records > webhookLogCompactFactor*live {
+		// A failed compaction leaves the current log in place and is retried after the next ack.
+		_ = q.compact()
+	}
+
+	return nil
+}
+
+// Close wakes up blocked Dequeue calls and closes the log. Unacknowledged webhooks are processed
+// after the queue is reopened.
+func (q *FileWebhookQueue) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return nil
+	}
+
+	q.closed = true
+	q.wake()
+
+	return q.file.Close()
+}
+
+// append writes record to the log, and syncs it when sync is set. On failure the log is truncated
+// back to its previous size, so a partial record cannot corrupt the records written after it.
+func (q *FileWebhookQueue) append(record webhookLogRecord, sync bool) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	n, err := q.file.Write(append(line, '\n'))
+	if err == nil && sync {
+		err = q.file.Sync()
+	}
+
+	if err != nil {
+		if n > 0 {
+			if truncateErr := q.file.Truncate(q.size); truncateErr != nil {
+				return errors.Join(err, truncateErr)
+			}
+		}
+
+		return err
+	}
+
+	q.size += int64(n)
+	q.records++
+
+	return nil
+}
+
+// compact rewrites the log with the unacknowledged webhooks, in-flight ones first, and reopens it
+// for appending. It must be called with q.mu held.
+func (q *FileWebhookQueue) compact() error {
+	webhooks := make([]*QueuedWebhook, 0, len(q.inflight)+len(q.pending))
+	for _, webhook := range q.inflight {
+		webhooks = append(webhooks, webhook)
+	}
+
+	slices.SortFunc(webhooks, func(a, b *QueuedWebhook) int {
+		return a.ReceivedAt.Compare(b.ReceivedAt)
+	})
+
+	webhooks = append(webhooks, q.pending...)
+
+	if err := writeWebhookLog(q.path, webhooks); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(q.path, os.O_WRONLY|os.O_APPEND, webhookQueueFilePerm)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	if q.file != nil {
+		_ = q.file.Close()
+	}
+
+	q.file = file
+	q.size = info.Size()
+	q.records = len(webhooks)
+
+	return nil
+}
+
+// wake releases every Dequeue call waiting on the current notify channel. It must be called with
+// q.mu held.
+func (q *FileWebhookQueue) wake() {
+	close(q.notify)
+	q.notify = make(chan struct{})
+}
+
+func readWebhookLog(path string) ([]*QueuedWebhook, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var (
+		order   []string
+		pending = make(map[string]*QueuedWebhook)
+	)
+
+	reader := bufio.NewReader(file)
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// A final record without a newline was torn by a crash during Enqueue. Enqueue had not
+			// returned, so the webhook was never acknowledged to Sumsub and is redelivered.
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		var record webhookLogRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case record.Op == webhookLogOpEnqueue && record.Webhook != nil:
+			order = append(order, record.Webhook.ID)
+			pending[record.Webhook.ID] = record.Webhook
+		case record.Op == webhookLogOpAck:
+			delete(pending, record.ID)
+		}
+	}
+
+	webhooks := make([]*QueuedWebhook, 0, len(pending))
+
+	for _, id := range order {
+		if webhook, ok := pending[id]; ok {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+
+	return webhooks, nil
+}
+
+func writeWebhookLog(path string, webhooks []*QueuedWebhook) error {
+	tmpPath := path + ".tmp"
+
+	file, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, webhookQueueFilePerm)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+
+	for _, webhook := range webhooks {
+		if err := encoder.Encode(webhookLogRecord{Op: webhookLogOpEnqueue, Webhook: webhook, ID: ""}); err != nil {
+			_ = file.Close()
+
+			return err
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,249 @@
+package gosumsub_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+func newTestQueuedWebhook(id, payload string) *gosumsub.QueuedWebhook {
+	return &gosumsub.QueuedWebhook{ID: id, Payload: []byte(payload), ReceivedAt: time.Unix(1700000000, 0).UTC()}
+}
+
+func TestMemoryWebhookQueue_EnqueueDequeue(t *testing.T) {
+	t.Parallel()
+
+	queue := gosumsub.NewMemoryWebhookQueue(1)
+	ctx := t.Context()
+
+	if err := queue.Enqueue(ctx, newTestQueuedWebhook("w1", "{}")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := queue.Enqueue(ctx, newTestQueuedWebhook("w2", "{}")); !errors.Is(err, gosumsub.ErrWebhookQueueFull) {
+		t.Errorf("expected ErrWebhookQueueFull, got %v", err)
+	}
+
+	webhook, err := queue.Dequeue(ctx)
+	if err != nil || webhook.ID != "w1" {
+		t.Fatalf("expected w1, got %+v, %v", webhook, err)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := queue.Dequeue(timeoutCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline error on empty queue, got %v", err)
+	}
+}
+
+func TestMemoryWebhookQueue_Close(t *testing.T) {
+	t.Parallel()
+
+	queue := gosumsub.NewMemoryWebhookQueue(0)
+
+	done := make(chan error)
+
+	go func() {
+		_, err := queue.Dequeue(context.Background())
+		done <- err
+	}()
+
+	_ = queue.Close()
+
+	if err := <-done; !errors.Is(err, gosumsub.ErrWebhookQueueClosed) {
+		t.Errorf("expected ErrWebhookQueueClosed, got %v", err)
+	}
+
+	if err := queue.Enqueue(t.Context(), newTestQueuedWebhook("w1", "{}")); !errors.Is(err, gosumsub.ErrWebhookQueueClosed) {
+		t.Errorf("expected ErrWebhookQueueClosed, got %v", err)
+	}
+}
+
+func TestFileWebhookQueue_RedeliversUnackedAfterReopen(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "webhooks.log")
+	ctx := t.Context()
+
+	queue, err := gosumsub.OpenFileWebhookQueue(path)
+	if err != nil {
+		t.Fatalf("failed to open queue: %v", err)
+	}
+
+	for _, id := range []string{"w1", "w2", "w3"} {
+		if err := queue.Enqueue(ctx, newTestQueuedWebhook(id, `{"type":"applicantCreated"}`)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	first, _ := queue.Dequeue(ctx)
+	second, _ := queue.Dequeue(ctx)
+
+	if err := queue.Ack(ctx, first); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if second.ID != "w2" {
+		t.Errorf("expected w2, got %s", second.ID)
+	}
+
+	if err := queue.Close(); err != nil {
+		t.Fatalf("failed to close queue: %v", err)
+	}
+
+	reopened, err := gosumsub.OpenFileWebhookQueue(path)
+	if err != nil {
+		t.Fatalf("failed to reopen queue: %v", err)
+	}
+	defer reopened.Close()
+
+	for _, want := range []string{"w2", "w3"} {
+		webhook, err := reopened.Dequeue(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if webhook.ID != want || string(webhook.Payload) != `{"type":"applicantCreated"}` {
+			t.Errorf("expected %s, got %+v", want, webhook)
+		}
+	}
+}
+
+func TestFileWebhookQueue_RecoversFromTornRecord(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "webhooks.log")
+	ctx := t.Context()
+
+	queue, err := gosumsub.OpenFileWebhookQueue(path)
+	if err != nil {
+		t.Fatalf("failed to open queue: %v", err)
+	}
+
+	for _, id := range []string{"w1", "w2"} {
+		if err := queue.Enqueue(ctx, newTestQueuedWebhook(id, `{"type":"applicantCreated"}`)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	_ = queue.Close()
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		t.Fatalf("failed to open log: %v", err)
+	}
+
+	_, _ = file.WriteString(`{"op":"enqueue","webhook":{"id":"w3","payload":`)
+	_ = file.Close()
+
+	reopened, err := gosumsub.OpenFileWebhookQueue(path)
+	if err != nil {
+		t.Fatalf("expected the torn record to be dropped, got %v", err)
+	}
+
+	if err := reopened.Enqueue(ctx, newTestQueuedWebhook("w4", `{"type":"applicantCreated"}`)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_ = reopened.Close()
+
+	again, err := gosumsub.OpenFileWebhookQueue(path)
+	if err != nil {
+		t.Fatalf("failed to reopen queue: %v", err)
+	}
+	defer again.Close()
+
+	for _, want := range []string{"w1", "w2", "w4"} {
+		webhook, err := again.Dequeue(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if webhook.ID != want {
+			t.Errorf("expected %s, got %s", want, webhook.ID)
+		}
+	}
+}
+
+func TestFileWebhookQueue_CompactsWhileRunning(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "webhooks.log")
+	ctx := t.Context()
+
+	queue, err := gosumsub.OpenFileWebhookQueue(path)
+	if err != nil {
+		t.Fatalf("failed to open queue: %v", err)
+	}
+
+	for i := range 1000 {
+		if err := queue.Enqueue(ctx, newTestQueuedWebhook(fmt.Sprintf("w%d", i), `{"type":"applicantCreated"}`)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		webhook, _ := queue.Dequeue(ctx)
+		if i == 999 {
+			break
+		}
+
+		if err := queue.Ack(ctx, webhook); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	_ = queue.Close()
+
+	data, _ := os.ReadFile(path)
+	if lines := strings.Count(string(data), "\n"); lines > 1024 {
+		t.Errorf("expected the log to be compacted while running, got %d records", lines)
+	}
+
+	reopened, err := gosumsub.OpenFileWebhookQueue(path)
+	if err != nil {
+		t.Fatalf("failed to reopen queue: %v", err)
+	}
+	defer reopened.Close()
+
+	webhook, err := reopened.Dequeue(ctx)
+	if err != nil || webhook.ID != "w999" {
+		t.Fatalf("expected the unacknowledged webhook w999, got %+v (%v)", webhook, err)
+	}
+}
+
+func TestFileWebhookQueue_DequeueWaitsForEnqueue(t *testing.T) {
+	t.Parallel()
+
+	queue, err := gosumsub.OpenFileWebhookQueue(filepath.Join(t.TempDir(), "webhooks.log"))
+	if err != nil {
+		t.Fatalf("failed to open queue: %v", err)
+	}
+	defer queue.Close()
+
+	done := make(chan *gosumsub.QueuedWebhook)
+
+	go func() {
+		webhook, _ := queue.Dequeue(context.Background())
+		done <- webhook
+	}()
+
+	if err := queue.Enqueue(t.Context(), newTestQueuedWebhook("w1", "{}")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	select {
+	case webhook := <-done:
+		if webhook == nil || webhook.ID != "w1" {
+			t.Errorf("expected w1, got %+v", webhook)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Dequeue did not return after Enqueue")
+	}
+}
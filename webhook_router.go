@@ -0,0 +1,356 @@
+package gosumsub
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type WebhookHandlerFunc func(ctx context.Context, event *WebhookEvent) error
+
+type (
+	ApplicantReviewedEvent            struct{ WebhookEvent }
+	ApplicantPendingEvent             struct{ WebhookEvent }
+	ApplicantCreatedEvent             struct{ WebhookEvent }
+	ApplicantOnHoldEvent              struct{ WebhookEvent }
+	ApplicantPersonalInfoChangedEvent struct{ WebhookEvent }
+	ApplicantPrecheckedEvent          struct{ WebhookEvent }
+	ApplicantDeletedEvent             struct{ WebhookEvent }
+	ApplicantLevelChangedEvent        struct{ WebhookEvent }
+	ApplicantResetEvent               struct{ WebhookEvent }
+	ApplicantWorkflowCompletedEvent   struct{ WebhookEvent }
+)
+
+type ApplicantActionEvent struct {
+	WebhookEvent
+	WebhookActionPayload
+}
+
+type (
+	ApplicantActionPendingEvent  struct{ ApplicantActionEvent }
+	ApplicantActionReviewedEvent struct{ ApplicantActionEvent }
+	ApplicantActionOnHoldEvent   struct{ ApplicantActionEvent }
+)
+
+type VideoIdentStatusChangedEvent struct {
+	WebhookEvent
+	WebhookVideoIdentPayload
+}
+
+// WebhookRouter verifies incoming webhooks and dispatches them to the handler registered for their
+// type. Events without a handler go to the fallback, or are acknowledged and dropped when there is
+// none. Handlers must be registered before the router starts serving requests.
+type WebhookRouter struct {
+	handlers     map[string]WebhookHandlerFunc
+	fallback     WebhookHandlerFunc
+	dedup        DedupStore
+	order        OrderStore
+	policy       StaleWebhookPolicy
+	verification WebhookMiddlewareOptions
+	verifier     *WebhookVerifier
+}
+
+type WebhookRouterOption func(*WebhookRouter)
+
+func NewWebhookRouter(secretKey string, opts ...WebhookRouterOption) *WebhookRouter {
+	var verification WebhookMiddlewareOptions
+
+	router := &WebhookRouter{
+		handlers:     make(map[string]WebhookHandlerFunc),
+		fallback:     nil,
+		dedup:        nil,
+		order:        nil,
+		policy:       StaleWebhookDrop,
+		verification: verification,
+		verifier:     nil,
+	}
+
+	for _, opt := range opts {
+		opt(router)
+	}
+
+	router.verifier = NewWebhookVerifier(secretKey, router.verification)
+
+	return router
+}
+
+// WithWebhookSecretProvider verifies requests against the secrets from provider instead of the
+// router's secret key. onMatch may be nil.
+func WithWebhookSecretProvider(provider SecretProvider, onMatch WebhookSecretMatchFunc) WebhookRouterOption {
+	return func(r *WebhookRouter) {
+		r.verification.SecretProvider = provider
+		r.verification.OnMatch = onMatch
+	}
+}
+
+// WithWebhookVerification configures request verification the way WebhookMiddlewareWithOptions does.
+// It replaces the settings of an earlier WithWebhookSecretProvider.
+func WithWebhookVerification(options WebhookMiddlewareOptions) WebhookRouterOption {
+	return func(r *WebhookRouter) {
+		r.verification = options
+	}
+}
+
+// WithWebhookDedup skips deliveries that store has already seen, as WebhookDedupMiddleware does.
+func WithWebhookDedup(store DedupStore) WebhookRouterOption {
+	return func(r *WebhookRouter) {
+		r.dedup = store
+	}
+}
+
+// On registers handler for eventType, replacing any previous handler for it.
+func (r *WebhookRouter) On(eventType string, handler WebhookHandlerFunc) {
+	r.handlers[eventType] = handler
+}
+
+// WithWebhookOrderGuard tracks event order per applicant in store and applies policy to stale
+// events, as WebhookOrderMiddleware does. Flagged events reach handlers with Stale set.
+func WithWebhookOrderGuard(store OrderStore, policy StaleWebhookPolicy) WebhookRouterOption {
+	return func(r *WebhookRouter) {
+		r.order = store
+		r.policy = policy
+	}
+}
+
+// Fallback registers handler for events that have no handler of their own.
+func (r *WebhookRouter) Fallback(handler WebhookHandlerFunc) {
+	r.fallback = handler
+}
+
+func (r *WebhookRouter) OnApplicantReviewed(handler func(ctx context.Context, event *ApplicantReviewedEvent) error) {
+	r.On(WebhookTypeApplicantReviewed, func(ctx context.Context, event *WebhookEvent) error {
+		return handler(ctx, &ApplicantReviewedEvent{WebhookEvent: *event})
+	})
+}
+
+func (r *WebhookRouter) OnApplicantPending(handler func(ctx context.Context, event *ApplicantPendingEvent) error) {
+	r.On(WebhookTypeApplicantPending, func(ctx context.Context, event *WebhookEvent) error {
+		return handler(ctx, &ApplicantPendingEvent{WebhookEvent: *event})
+	})
+}
+
+func (r *WebhookRouter) OnApplicantCreated(handler func(ctx context.Context, event *ApplicantCreatedEvent) error) {
+	r.On(WebhookTypeApplicantCreated, func(ctx context.Context, event *WebhookEvent) error {
+		return handler(ctx, &ApplicantCreatedEvent{WebhookEvent: *event})
+	})
+}
+
+func (r *WebhookRouter) OnApplicantOnHold(handler func(ctx context.Context, event *ApplicantOnHoldEvent) error) {
+	r.On(WebhookTypeApplicantOnHold, func(ctx context.Context, event *WebhookEvent) error {
+		return handler(ctx, &ApplicantOnHoldEvent{WebhookEvent: *event})
+	})
+}
+
+func (r *WebhookRouter) OnApplicantPersonalInfoChanged(
+	handler func(ctx context.Context, event *ApplicantPersonalInfoChangedEvent) error,
+) {
+	r.On(WebhookTypeApplicantPersonalInfoChanged, func(ctx context.Context, event *WebhookEvent) error {
+		return handler(ctx, &ApplicantPersonalInfoChangedEvent{WebhookEvent: *event})
+	})
+}
+
+func (r *WebhookRouter) OnApplicantPrechecked(handler func(ctx context.Context, event *ApplicantPrecheckedEvent) error) {
+	r.On(WebhookTypeApplicantPrechecked, func(ctx context.Context, event *WebhookEvent) error {
+		return handler(ctx, &ApplicantPrecheckedEvent{WebhookEvent: *event})
+	})
+}
+
+func (r *WebhookRouter) OnApplicantDeleted(handler func(ctx context.Context, event *ApplicantDeletedEvent) error) {
+	r.On(WebhookTypeApplicantDeleted, func(ctx context.Context, event *WebhookEvent) error {
+		return handler(ctx, &ApplicantDeletedEvent{WebhookEvent: *event})
+	})
+}
+
+func (r *WebhookRouter) OnApplicantLevelChanged(
+	handler func(ctx context.Context, event *ApplicantLevelChangedEvent) error,
+) {
+	r.On(WebhookTypeApplicantLevelChanged, func(ctx context.Context, event *WebhookEvent) error {
+		return handler(ctx, &ApplicantLevelChangedEvent{WebhookEvent: *event})
+	})
+}
+
+func (r *WebhookRouter) OnApplicantReset(handler func(ctx context.Context, event *ApplicantResetEvent) error) {
+	r.On(WebhookTypeApplicantReset, func(ctx context.Context, event *WebhookEvent) error {
+		return handler(ctx, &ApplicantResetEvent{WebhookEvent: *event})
+	})
+}
+
+func (r *WebhookRouter) OnApplicantWorkflowCompleted(
+	handler func(ctx context.Context, event *ApplicantWorkflowCompletedEvent) error,
+) {
+	r.On(WebhookTypeApplicantWorkflowCompleted, func(ctx context.Context, event *WebhookEvent) error {
+		return handler(ctx, &ApplicantWorkflowCompletedEvent{WebhookEvent: *event})
+	})
+}
+
+func (r *WebhookRouter) OnApplicantActionPending(
+	handler func(ctx context.Context, event *ApplicantActionPendingEvent) error,
+) {
+	r.On(WebhookTypeApplicantActionPending, func(ctx context.Context, event *WebhookEvent) error {
+		action, err := newApplicantActionEvent(event)
+		if err != nil {
+			return err
+		}
+
+		return handler(ctx, &ApplicantActionPendingEvent{ApplicantActionEvent: *action})
+	})
+}
+
+func (r *WebhookRouter) OnApplicantActionReviewed(
+	handler func(ctx context.Context, event *ApplicantActionReviewedEvent) error,
+) {
+	r.On(WebhookTypeApplicantActionReviewed, func(ctx context.Context, event *WebhookEvent) error {
+		action, err := newApplicantActionEvent(event)
+		if err != nil {
+			return err
+		}
+
+		return handler(ctx, &ApplicantActionReviewedEvent{ApplicantActionEvent: *action})
+	})
+}
+
+func (r *WebhookRouter) OnApplicantActionOnHold(
+	handler func(ctx context.Context, event *ApplicantActionOnHoldEvent) error,
+) {
+	r.On(WebhookTypeApplicantActionOnHold, func(ctx context.Context, event *WebhookEvent) error {
+		action, err := newApplicantActionEvent(event)
+		if err != nil {
+			return err
+		}
+
+		return handler(ctx, &ApplicantActionOnHoldEvent{ApplicantActionEvent: *action})
+	})
+}
+
+func (r *WebhookRouter) OnVideoIdentStatusChanged(
+	handler func(ctx context.Context, event *VideoIdentStatusChangedEvent) error,
+) {
+	r.On(WebhookTypeVideoIdentStatusChanged, func(ctx context.Context, event *WebhookEvent) error {
+		videoIdent, err := event.VideoIdent()
+		if err != nil {
+			return err
+		}
+
+		return handler(ctx, &VideoIdentStatusChangedEvent{WebhookEvent: *event, WebhookVideoIdentPayload: *videoIdent})
+	})
+}
+
+// Dispatch runs the handler registered for event.Type, or the fallback, bypassing deduplication and
+// the ordering guard. It can be used to replay events on purpose; use Handle for deliveries.
+func (r *WebhookRouter) Dispatch(ctx context.Context, event *WebhookEvent) error {
+	if handler, ok := r.handlers[event.Type]; ok {
+		return handler(ctx, event)
+	}
+
+	if r.fallback != nil {
+		return r.fallback(ctx, event)
+	}
+
+	return nil
+}
+
+// ServeHTTP responds 401 to unverified requests, 400 to payloads that are not webhook events and
+// 500 when the handler fails, so that Sumsub delivers the event again.
+func (r *WebhookRouter) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	body, err := r.verifier.Verify(request)
+	if err != nil {
+		r.verifier.WriteError(writer, request, err)
+
+		return
+	}
+
+	status := r.handle(request.Context(), body)
+	if status != http.StatusOK {
+		http.Error(writer, http.StatusText(status), status)
+
+		return
+	}
+
+	writer.WriteHeader(http.StatusOK)
+}
+
+func (r *WebhookRouter) EchoHandler() echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		body, err := r.verifier.Verify(ctx.Request())
+		if err != nil {
+			r.verifier.WriteError(ctx.Response(), ctx.Request(), err)
+
+			return nil
+		}
+
+		status := r.handle(ctx.Request().Context(), body)
+		if status != http.StatusOK {
+			return ctx.String(status, http.StatusText(status))
+		}
+
+		return ctx.NoContent(http.StatusOK)
+	}
+}
+
+func (r *WebhookRouter) handle(ctx context.Context, body []byte) int {
+	ctx, event, err := withWebhook(ctx, body)
+	if err != nil {
+		return http.StatusBadRequest
+	}
+
+	if err := r.Handle(ctx, event); err != nil {
+		return http.StatusInternalServerError
+	}
+
+	return http.StatusOK
+}
+
+// Handle runs event through the router's deduplication and ordering guard, when configured, and then
+// dispatches it. Duplicates and stale events dropped by StaleWebhookDrop return nil without calling
+// a handler. Unlike Dispatch, it is safe to pass to NewWebhookProcessor: redeliveries that were
+// queued twice are handled once.
+func (r *WebhookRouter) Handle(ctx context.Context, event *WebhookEvent) error {
+	key := WebhookDedupKey(event)
+
+	if r.dedup != nil {
+		duplicate, err := r.dedup.Claim(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		if duplicate {
+			return nil
+		}
+	}
+
+	if r.order != nil {
+		if err := CheckWebhookOrder(ctx, r.order, event); err != nil {
+			r.forget(ctx, key)
+
+			return err
+		}
+
+		if event.Stale && r.policy == StaleWebhookDrop {
+			return nil
+		}
+	}
+
+	if err := r.Dispatch(ctx, event); err != nil {
+		r.forget(ctx, key)
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *WebhookRouter) forget(ctx context.Context, key string) {
+	if r.dedup != nil {
+		_ = r.dedup.Forget(context.WithoutCancel(ctx), key)
+	}
+}
+
+func newApplicantActionEvent(event *WebhookEvent) (*ApplicantActionEvent, error) {
+	action, err := event.Action()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicantActionEvent{WebhookEvent: *event, WebhookActionPayload: *action}, nil
+}
@@ -0,0 +1,210 @@
+package gosumsub_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+	"github.com/labstack/echo/v4"
+)
+
+var errHandlerFailed = errors.New("handler failed")
+
+func newSignedWebhookRequest(body, secretKey string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	req.Header.Set(gosumsub.HeaderDigestAlg, gosumsub.AlgoHMACSHA256)
+	req.Header.Set(gosumsub.HeaderDigest, computeHMACSHA256(body, secretKey))
+
+	return req
+}
+
+func TestWebhookRouter_DispatchesByType(t *testing.T) {
+	t.Parallel()
+
+	router := gosumsub.NewWebhookRouter(testSecretKey)
+
+	var reviewed *gosumsub.ApplicantReviewedEvent
+
+	router.OnApplicantReviewed(func(_ context.Context, event *gosumsub.ApplicantReviewedEvent) error {
+		reviewed = event
+
+		return nil
+	})
+	router.OnApplicantPending(func(context.Context, *gosumsub.ApplicantPendingEvent) error {
+		t.Error("unexpected call to pending handler")
+
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, newSignedWebhookRequest(testReviewedWebhookBody, testSecretKey))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+
+	if reviewed == nil || reviewed.ApplicantID != "app123" || reviewed.ReviewResult.ReviewAnswer != gosumsub.ReviewAnswerRed {
+		t.Errorf("unexpected reviewed event %+v", reviewed)
+	}
+}
+
+func TestWebhookRouter_ActionEvent(t *testing.T) {
+	t.Parallel()
+
+	router := gosumsub.NewWebhookRouter(testSecretKey)
+
+	var actionID string
+
+	router.OnApplicantActionReviewed(func(_ context.Context, event *gosumsub.ApplicantActionReviewedEvent) error {
+		actionID = event.ApplicantActionID
+
+		return nil
+	})
+
+	body := `{"type":"applicantActionReviewed","applicantId":"app123","applicantActionId":"action-1"}`
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, newSignedWebhookRequest(body, testSecretKey))
+
+	if rec.Code != http.StatusOK || actionID != "action-1" {
+		t.Errorf("expected 200 with action-1, got %d and %q", rec.Code, actionID)
+	}
+}
+
+func TestWebhookRouter_Fallback(t *testing.T) {
+	t.Parallel()
+
+	router := gosumsub.NewWebhookRouter(testSecretKey)
+
+	var fallbackType string
+
+	router.Fallback(func(_ context.Context, event *gosumsub.WebhookEvent) error {
+		fallbackType = event.Type
+
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, newSignedWebhookRequest(`{"type":"somethingNew"}`, testSecretKey))
+
+	if rec.Code != http.StatusOK || fallbackType != "somethingNew" {
+		t.Errorf("expected fallback for somethingNew, got %d and %q", rec.Code, fallbackType)
+	}
+}
+
+func TestWebhookRouter_UnhandledWithoutFallback(t *testing.T) {
+	t.Parallel()
+
+	router := gosumsub.NewWebhookRouter(testSecretKey)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, newSignedWebhookRequest(`{"type":"somethingNew"}`, testSecretKey))
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+}
+
+func TestWebhookRouter_ErrorStatuses(t *testing.T) {
+	t.Parallel()
+
+	router := gosumsub.NewWebhookRouter(testSecretKey)
+	router.OnApplicantReviewed(func(context.Context, *gosumsub.ApplicantReviewedEvent) error {
+		return errHandlerFailed
+	})
+
+	tests := []struct {
+		name       string
+		request    *http.Request
+		wantStatus int
+	}{
+		{
+			name:       "handler error",
+			request:    newSignedWebhookRequest(testReviewedWebhookBody, testSecretKey),
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:       "invalid signature",
+			request:    newSignedWebhookRequest(testReviewedWebhookBody, "wrong-secret"),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "not a webhook event",
+			request:    newSignedWebhookRequest(`{"applicantId":"app123"}`, testSecretKey),
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, testCase.request)
+
+			if rec.Code != testCase.wantStatus {
+				t.Errorf("expected status %d, got %d", testCase.wantStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestWebhookRouter_Dispatch(t *testing.T) {
+	t.Parallel()
+
+	router := gosumsub.NewWebhookRouter(testSecretKey)
+	router.On(gosumsub.WebhookTypeApplicantCreated, func(context.Context, *gosumsub.WebhookEvent) error {
+		return errHandlerFailed
+	})
+
+	event, err := gosumsub.ParseWebhook([]byte(`{"type":"applicantCreated"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := router.Dispatch(t.Context(), event); !errors.Is(err, errHandlerFailed) {
+		t.Errorf("expected handler error, got %v", err)
+	}
+}
+
+func TestWebhookRouter_EchoHandler(t *testing.T) {
+	t.Parallel()
+
+	router := gosumsub.NewWebhookRouter(testEchoSecretKey)
+
+	var called bool
+
+	router.OnApplicantReviewed(func(context.Context, *gosumsub.ApplicantReviewedEvent) error {
+		called = true
+
+		return nil
+	})
+
+	handler := router.EchoHandler()
+	echoInstance := echo.New()
+
+	req := newSignedWebhookRequest(testReviewedWebhookBody, testEchoSecretKey)
+	rec := httptest.NewRecorder()
+
+	if err := handler(echoInstance.NewContext(req, rec)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rec.Code != http.StatusOK || !called {
+		t.Errorf("expected handler call and status 200, got %d", rec.Code)
+	}
+
+	req = newSignedWebhookRequest(testReviewedWebhookBody, "wrong")
+	rec = httptest.NewRecorder()
+
+	if err := handler(echoInstance.NewContext(req, rec)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+	}
+}
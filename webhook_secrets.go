@@ -0,0 +1,66 @@
+package gosumsub
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// SecretProvider returns the webhook secrets that are currently accepted. During a rotation it
+// returns both the new and the old secret, so deliveries signed with either are verified.
+type SecretProvider interface {
+	WebhookSecrets(ctx context.Context) ([]string, error)
+}
+
+// StaticSecrets is a fixed list of accepted secrets.
+type StaticSecrets []string
+
+func (s StaticSecrets) WebhookSecrets(context.Context) ([]string, error) {
+	return s, nil
+}
+
+type SecretProviderFunc func(ctx context.Context) ([]string, error)
+
+func (f SecretProviderFunc) WebhookSecrets(ctx context.Context) ([]string, error) {
+	return f(ctx)
+}
+
+// WebhookSecretMatchFunc is told which secret, by its index in the provider's list, verified a
+// request. Once the old secret stops matching, it can be retired.
+type WebhookSecretMatchFunc func(request *http.Request, index int)
+
+// VerifyWebhookDigestWithSecrets is VerifyWebhookDigest for several secrets. Every secret is checked,
+// so the time taken does not reveal which one matched, and the index of the matching secret is
+// returned. Empty secrets are skipped; ErrEmptySecretKey is returned only when none is set.
+func VerifyWebhookDigestWithSecrets(payload []byte, secretKeys []string, algo, digestHex string) (int, error) {
+	return verifyWebhookDigest(payload, secretKeys, algo, digestHex)
+}
+
+// VerifyWebhookRequestWithSecrets verifies request against the secrets from provider and returns
+// the body and the index of the matching secret. The request body can be read again afterwards.
+func VerifyWebhookRequestWithSecrets(request *http.Request, provider SecretProvider) ([]byte, int, error) {
+	return verifyWebhookRequest(request, provider, noBodyLimit)
+}
+
+// WebhookMiddlewareWithSecrets is WebhookMiddleware for several accepted secrets. onMatch may be nil.
+func WebhookMiddlewareWithSecrets(provider SecretProvider, onMatch WebhookSecretMatchFunc) func(http.Handler) http.Handler {
+	var options WebhookMiddlewareOptions
+
+	options.SecretProvider = provider
+	options.OnMatch = onMatch
+
+	return WebhookMiddlewareWithOptions("", options)
+}
+
+func EchoWebhookMiddlewareWithSecrets(
+	provider SecretProvider,
+	onMatch WebhookSecretMatchFunc,
+) func(next echo.HandlerFunc) echo.HandlerFunc {
+	var options WebhookMiddlewareOptions
+
+	options.SecretProvider = provider
+	options.OnMatch = onMatch
+
+	return EchoWebhookMiddlewareWithOptions("", options)
+}
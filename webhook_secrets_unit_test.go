@@ -0,0 +1,165 @@
+package gosumsub_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+	"github.com/labstack/echo/v4"
+)
+
+var errSecretsUnavailable = errors.New("secrets unavailable")
+
+func TestVerifyWebhookDigestWithSecrets_ReportsMatchedIndex(t *testing.T) {
+	t.Parallel()
+
+	payload := []byte(testApplicantBody)
+	secrets := []string{"new-secret", "old-secret"}
+
+	tests := []struct {
+		name      string
+		signedBy  string
+		wantIndex int
+		wantErr   error
+	}{
+		{name: "new secret", signedBy: "new-secret", wantIndex: 0, wantErr: nil},
+		{name: "old secret", signedBy: "old-secret", wantIndex: 1, wantErr: nil},
+		{name: "unknown secret", signedBy: "other", wantIndex: -1, wantErr: gosumsub.ErrDigestMismatch},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			digest := computeHMACSHA256(string(payload), testCase.signedBy)
+
+			index, err := gosumsub.VerifyWebhookDigestWithSecrets(payload, secrets, gosumsub.AlgoHMACSHA256, digest)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("expected %v, got %v", testCase.wantErr, err)
+			}
+
+			if index != testCase.wantIndex {
+				t.Errorf("expected index %d, got %d", testCase.wantIndex, index)
+			}
+		})
+	}
+}
+
+func TestVerifyWebhookDigestWithSecrets_EmptySecrets(t *testing.T) {
+	t.Parallel()
+
+	digest := computeHMACSHA256(testApplicantBody, "secret")
+
+	for _, secrets := range [][]string{nil, {""}, {"", ""}} {
+		_, err := gosumsub.VerifyWebhookDigestWithSecrets([]byte(testApplicantBody), secrets, gosumsub.AlgoHMACSHA256, digest)
+		if !errors.Is(err, gosumsub.ErrEmptySecretKey) {
+			t.Errorf("secrets %q: expected ErrEmptySecretKey, got %v", secrets, err)
+		}
+	}
+}
+
+func TestVerifyWebhookDigestWithSecrets_SkipsEmptySecrets(t *testing.T) {
+	t.Parallel()
+
+	digest := computeHMACSHA256(testApplicantBody, "old-secret")
+
+	index, err := gosumsub.VerifyWebhookDigestWithSecrets(
+		[]byte(testApplicantBody), []string{"", "old-secret"}, gosumsub.AlgoHMACSHA256, digest,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if index != 1 {
+		t.Errorf("expected index 1, got %d", index)
+	}
+}
+
+func TestVerifyWebhookRequestWithSecrets_ProviderError(t *testing.T) {
+	t.Parallel()
+
+	provider := gosumsub.SecretProviderFunc(func(context.Context) ([]string, error) {
+		return nil, errSecretsUnavailable
+	})
+
+	_, _, err := gosumsub.VerifyWebhookRequestWithSecrets(newSignedWebhookRequest(testApplicantBody, "secret"), provider)
+	if !errors.Is(err, errSecretsUnavailable) {
+		t.Errorf("expected provider error, got %v", err)
+	}
+}
+
+func TestWebhookMiddlewareWithSecrets(t *testing.T) {
+	t.Parallel()
+
+	var matched []int
+
+	handler := gosumsub.WebhookMiddlewareWithSecrets(
+		gosumsub.StaticSecrets{"new-secret", "old-secret"},
+		func(_ *http.Request, index int) { matched = append(matched, index) },
+	)(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusOK)
+	}))
+
+	wantStatuses := map[string]int{
+		"old-secret": http.StatusOK,
+		"new-secret": http.StatusOK,
+		"other":      http.StatusUnauthorized,
+	}
+
+	for _, secret := range []string{"old-secret", "new-secret", "other"} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newSignedWebhookRequest(testApplicantBody, secret))
+
+		if rec.Code != wantStatuses[secret] {
+			t.Errorf("%s: expected status %d, got %d", secret, wantStatuses[secret], rec.Code)
+		}
+	}
+
+	if len(matched) != 2 || matched[0] != 1 || matched[1] != 0 {
+		t.Errorf("expected matched indexes [1 0], got %v", matched)
+	}
+}
+
+func TestEchoWebhookMiddlewareWithSecrets(t *testing.T) {
+	t.Parallel()
+
+	matched := -1
+
+	handler := gosumsub.EchoWebhookMiddlewareWithSecrets(
+		gosumsub.StaticSecrets{"new-secret", "old-secret"},
+		func(_ *http.Request, index int) { matched = index },
+	)(func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	req := newSignedWebhookRequest(testApplicantBody, "old-secret")
+	rec := httptest.NewRecorder()
+
+	if err := handler(echo.New().NewContext(req, rec)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rec.Code != http.StatusOK || matched != 1 {
+		t.Errorf("expected 200 with old secret matched, got %d and %d", rec.Code, matched)
+	}
+}
+
+func TestWebhookRouter_WithSecretProvider(t *testing.T) {
+	t.Parallel()
+
+	matched := -1
+	router := gosumsub.NewWebhookRouter("", gosumsub.WithWebhookSecretProvider(
+		gosumsub.StaticSecrets{"new-secret", "old-secret"},
+		func(_ *http.Request, index int) { matched = index },
+	))
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, newSignedWebhookRequest(testReviewedWebhookBody, "new-secret"))
+
+	if rec.Code != http.StatusOK || matched != 0 {
+		t.Errorf("expected 200 with new secret matched, got %d and %d", rec.Code, matched)
+	}
+}
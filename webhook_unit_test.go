@@ -147,6 +147,65 @@ func TestVerifyWebhookDigest_DigestMismatch(t *testing.T) {
 	}
 }
 
+func TestSignWebhook_AllAlgorithms(t *testing.T) {
+	t.Parallel()
+
+	payload := `{"applicantId":"app123","type":"applicantReviewed"}`
+
+	tests := []struct {
+		algo string
+		want string
+	}{
+		{algo: gosumsub.AlgoHMACSHA1, want: computeHMACSHA1(payload, testSecretKey)},
+		{algo: gosumsub.AlgoHMACSHA256, want: computeHMACSHA256(payload, testSecretKey)},
+		{algo: gosumsub.AlgoHMACSHA512, want: computeHMACSHA512(payload, testSecretKey)},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.algo, func(t *testing.T) {
+			t.Parallel()
+
+			digest, err := gosumsub.SignWebhook([]byte(payload), testSecretKey, testCase.algo)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if digest != testCase.want {
+				t.Errorf("expected digest %q, got %q", testCase.want, digest)
+			}
+
+			if err := gosumsub.VerifyWebhookDigest([]byte(payload), testSecretKey, testCase.algo, digest); err != nil {
+				t.Errorf("expected signed digest to verify, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSignWebhook_KnownDigest(t *testing.T) {
+	t.Parallel()
+
+	digest, err := gosumsub.SignWebhook([]byte("someText"), "SoMe_SeCrEt_KeY", gosumsub.AlgoHMACSHA1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if digest != "f6e92ffe371718694d46e28436f76589312df8db" {
+		t.Errorf("unexpected digest %q", digest)
+	}
+}
+
+func TestSignWebhook_Errors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := gosumsub.SignWebhook([]byte("test"), "", gosumsub.AlgoHMACSHA256); !errors.Is(err, gosumsub.ErrEmptySecretKey) {
+		t.Errorf("expected ErrEmptySecretKey, got %v", err)
+	}
+
+	if _, err := gosumsub.SignWebhook([]byte("test"), testSecretKey, "HMAC_MD5_HEX"); !errors.Is(err, gosumsub.ErrUnsupportedAlgo) {
+		t.Errorf("expected ErrUnsupportedAlgo, got %v", err)
+	}
+}
+
 func TestVerifyWebhookRequest_ValidSHA256(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,196 @@
+package gosumsub
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// DefaultWebhookMaxBodyBytes is the body size limit WebhookMiddlewareOptions applies when
+// MaxBodyBytes is zero. Sumsub payloads are a few kilobytes.
+const DefaultWebhookMaxBodyBytes = 1 << 20
+
+const noBodyLimit = -1
+
+// WebhookErrorWriter writes the response for a request that failed verification.
+type WebhookErrorWriter func(writer http.ResponseWriter, request *http.Request, err error)
+
+type WebhookMiddlewareOptions struct {
+	// SecretProvider, when set, is used instead of the secret key passed to the middleware.
+	SecretProvider SecretProvider
+	OnMatch        WebhookSecretMatchFunc
+	// MaxBodyBytes limits how much of the unauthenticated body is read. Zero means
+	// DefaultWebhookMaxBodyBytes and a negative value disables the limit.
+	MaxBodyBytes int64
+	// OnError is called with the verification error, which wraps one of ErrMissingDigest,
+	// ErrMissingDigestAlgo, ErrBodyTooLarge, ErrReadBody, ErrWebhookSecrets, ErrUnsupportedAlgo,
+	// ErrMalformedDigest, ErrEmptySecretKey or ErrDigestMismatch.
+	OnError func(request *http.Request, err error)
+	// Logger, when set, logs failed verifications.
+	Logger Logger
+	// WriteError replaces the default response: 413 for ErrBodyTooLarge and 401 "unauthorized"
+	// otherwise.
+	WriteError WebhookErrorWriter
+}
+
+// WebhookVerifier is the verification step shared by the webhook middlewares, the router and the
+// async handler. Adapters for other frameworks use it to get the same behavior.
+type WebhookVerifier struct {
+	secrets SecretProvider
+	options WebhookMiddlewareOptions
+}
+
+func NewWebhookVerifier(secretKey string, options WebhookMiddlewareOptions) *WebhookVerifier {
+	secrets := options.SecretProvider
+	if secrets == nil {
+		secrets = StaticSecrets{secretKey}
+	}
+
+	if options.MaxBodyBytes == 0 {
+		options.MaxBodyBytes = DefaultWebhookMaxBodyBytes
+	}
+
+	if options.WriteError == nil {
+		options.WriteError = writeWebhookError
+	}
+
+	return &WebhookVerifier{secrets: secrets, options: options}
+}
+
+// Verify checks request and returns the verified body, which is also put back into request.Body.
+// Failures are logged and passed to OnError before they are returned.
+func (v *WebhookVerifier) Verify(request *http.Request) ([]byte, error) {
+	body, index, err := verifyWebhookRequest(request, v.secrets, v.options.MaxBodyBytes)
+	if err != nil {
+		if v.options.Logger != nil {
+			v.options.Logger.Error("webhook verification failed", "error", err, "remoteAddr", request.RemoteAddr)
+		}
+
+		if v.options.OnError != nil {
+			v.options.OnError(request, err)
+		}
+
+		return nil, err
+	}
+
+	if v.options.OnMatch != nil {
+		v.options.OnMatch(request, index)
+	}
+
+	return body, nil
+}
+
+// WriteError writes the response for a failed verification with the configured WebhookErrorWriter.
+func (v *WebhookVerifier) WriteError(writer http.ResponseWriter, request *http.Request, err error) {
+	v.options.WriteError(writer, request, err)
+}
+
+// WebhookMiddlewareWithOptions verifies requests and stores the verified body and parsed event in the
+// request context, see WebhookBodyFromContext and WebhookEventFromContext.
+func WebhookMiddlewareWithOptions(secretKey string, options WebhookMiddlewareOptions) func(http.Handler) http.Handler {
+	verifier := NewWebhookVerifier(secretKey, options)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, err := verifier.Verify(request)
+			if err != nil {
+				verifier.WriteError(writer, request, err)
+
+				return
+			}
+
+			ctx, _, _ := withWebhook(request.Context(), body)
+
+			next.ServeHTTP(writer, request.WithContext(ctx))
+		})
+	}
+}
+
+// EchoWebhookMiddlewareWithOptions is WebhookMiddlewareWithOptions for Echo. The body and event are
+// also set on the echo.Context under EchoContextKeyWebhookBody and EchoContextKeyWebhookEvent.
+func EchoWebhookMiddlewareWithOptions(
+	secretKey string,
+	options WebhookMiddlewareOptions,
+) func(next echo.HandlerFunc) echo.HandlerFunc {
+	verifier := NewWebhookVerifier(secretKey, options)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			body, err := verifier.Verify(ctx.Request())
+			if err != nil {
+				verifier.WriteError(ctx.Response(), ctx.Request(), err)
+
+				return nil
+			}
+
+			setEchoWebhook(ctx, body)
+
+			return next(ctx)
+		}
+	}
+}
+
+func writeWebhookError(writer http.ResponseWriter, _ *http.Request, err error) {
+	if errors.Is(err, ErrBodyTooLarge) {
+		http.Error(writer, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+
+		return
+	}
+
+	http.Error(writer, "unauthorized", http.StatusUnauthorized)
+}
+
+// verifyWebhookRequest returns the verified body, which is also put back into request.Body, and the
+// index of the secret that matched.
+func verifyWebhookRequest(request *http.Request, secrets SecretProvider, maxBodyBytes int64) ([]byte, int, error) {
+	algo := request.Header.Get(HeaderDigestAlg)
+	if algo == "" {
+		return nil, -1, ErrMissingDigestAlgo
+	}
+
+	digest := request.Header.Get(HeaderDigest)
+	if digest == "" {
+		return nil, -1, ErrMissingDigest
+	}
+
+	secretKeys, err := secrets.WebhookSecrets(request.Context())
+	if err != nil {
+		return nil, -1, fmt.Errorf("%w: %w", ErrWebhookSecrets, err)
+	}
+
+	body, err := readWebhookBody(request, maxBodyBytes)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	index, err := verifyWebhookDigest(body, secretKeys, algo, digest)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	return body, index, nil
+}
+
+func readWebhookBody(request *http.Request, maxBodyBytes int64) ([]byte, error) {
+	reader := request.Body
+	if maxBodyBytes >= 0 {
+		reader = io.NopCloser(io.LimitReader(request.Body, maxBodyBytes+1))
+	}
+
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReadBody, err)
+	}
+
+	if maxBodyBytes >= 0 && int64(len(body)) > maxBodyBytes {
+		return nil, ErrBodyTooLarge
+	}
+
+	request.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
@@ -0,0 +1,212 @@
+package gosumsub_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+	"github.com/labstack/echo/v4"
+)
+
+type recordingLogger struct {
+	mu     sync.Mutex
+	errors []string
+}
+
+func (l *recordingLogger) Info(string, ...any)  {}
+func (l *recordingLogger) Debug(string, ...any) {}
+
+func (l *recordingLogger) Error(msg string, _ ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.errors = append(l.errors, msg)
+}
+
+func okHandler() http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusOK)
+	})
+}
+
+func TestWebhookMiddlewareWithOptions_OnErrorSentinels(t *testing.T) {
+	t.Parallel()
+
+	missingAlgo := newSignedWebhookRequest(testApplicantBody, testSecretKey)
+	missingAlgo.Header.Del(gosumsub.HeaderDigestAlg)
+
+	missingDigest := newSignedWebhookRequest(testApplicantBody, testSecretKey)
+	missingDigest.Header.Del(gosumsub.HeaderDigest)
+
+	tests := []struct {
+		name    string
+		request *http.Request
+		wantErr error
+	}{
+		{name: "missing algorithm", request: missingAlgo, wantErr: gosumsub.ErrMissingDigestAlgo},
+		{name: "missing digest", request: missingDigest, wantErr: gosumsub.ErrMissingDigest},
+		{name: "wrong secret", request: newSignedWebhookRequest(testApplicantBody, "wrong"), wantErr: gosumsub.ErrDigestMismatch},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotErr error
+
+			var options gosumsub.WebhookMiddlewareOptions
+
+			options.OnError = func(_ *http.Request, err error) { gotErr = err }
+
+			rec := httptest.NewRecorder()
+			gosumsub.WebhookMiddlewareWithOptions(testSecretKey, options)(okHandler()).ServeHTTP(rec, testCase.request)
+
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+			}
+
+			if !errors.Is(gotErr, testCase.wantErr) {
+				t.Errorf("expected %v, got %v", testCase.wantErr, gotErr)
+			}
+		})
+	}
+}
+
+func TestWebhookMiddlewareWithOptions_MaxBodyBytes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		maxBodyBytes int64
+		bodySize     int
+		wantStatus   int
+	}{
+		{name: "within limit", maxBodyBytes: 64, bodySize: 64, wantStatus: http.StatusOK},
+		{name: "over limit", maxBodyBytes: 64, bodySize: 65, wantStatus: http.StatusRequestEntityTooLarge},
+		{
+			name:         "default limit",
+			maxBodyBytes: 0,
+			bodySize:     gosumsub.DefaultWebhookMaxBodyBytes + 1,
+			wantStatus:   http.StatusRequestEntityTooLarge,
+		},
+		{name: "no limit", maxBodyBytes: -1, bodySize: gosumsub.DefaultWebhookMaxBodyBytes + 1, wantStatus: http.StatusOK},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotErr error
+
+			var options gosumsub.WebhookMiddlewareOptions
+
+			options.MaxBodyBytes = testCase.maxBodyBytes
+			options.OnError = func(_ *http.Request, err error) { gotErr = err }
+
+			body := strings.Repeat("a", testCase.bodySize)
+			rec := httptest.NewRecorder()
+			gosumsub.WebhookMiddlewareWithOptions(testSecretKey, options)(okHandler()).
+				ServeHTTP(rec, newSignedWebhookRequest(body, testSecretKey))
+
+			if rec.Code != testCase.wantStatus {
+				t.Errorf("expected status %d, got %d", testCase.wantStatus, rec.Code)
+			}
+
+			if testCase.wantStatus == http.StatusRequestEntityTooLarge && !errors.Is(gotErr, gosumsub.ErrBodyTooLarge) {
+				t.Errorf("expected ErrBodyTooLarge, got %v", gotErr)
+			}
+		})
+	}
+}
+
+func TestWebhookMiddlewareWithOptions_LoggerAndWriteError(t *testing.T) {
+	t.Parallel()
+
+	logger := &recordingLogger{mu: sync.Mutex{}, errors: nil}
+
+	var options gosumsub.WebhookMiddlewareOptions
+
+	options.Logger = logger
+	options.WriteError = func(writer http.ResponseWriter, _ *http.Request, err error) {
+		writer.Header().Set("Content-Type", "application/json")
+		writer.WriteHeader(http.StatusForbidden)
+		_, _ = writer.Write([]byte(`{"error":"` + err.Error() + `"}`))
+	}
+
+	rec := httptest.NewRecorder()
+	gosumsub.WebhookMiddlewareWithOptions(testSecretKey, options)(okHandler()).
+		ServeHTTP(rec, newSignedWebhookRequest(testApplicantBody, "wrong"))
+
+	if rec.Code != http.StatusForbidden || rec.Body.String() != `{"error":"digest mismatch"}` {
+		t.Errorf("unexpected response %d %q", rec.Code, rec.Body.String())
+	}
+
+	if len(logger.errors) != 1 {
+		t.Errorf("expected 1 logged error, got %d", len(logger.errors))
+	}
+}
+
+func TestEchoWebhookMiddlewareWithOptions_WriteError(t *testing.T) {
+	t.Parallel()
+
+	var options gosumsub.WebhookMiddlewareOptions
+
+	options.WriteError = func(writer http.ResponseWriter, _ *http.Request, _ error) {
+		http.Error(writer, "nope", http.StatusTeapot)
+	}
+
+	handler := gosumsub.EchoWebhookMiddlewareWithOptions(testEchoSecretKey, options)(func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+	echoInstance := echo.New()
+
+	rec := httptest.NewRecorder()
+	if err := handler(echoInstance.NewContext(newSignedWebhookRequest(testApplicantBody, "wrong"), rec)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rec.Code != http.StatusTeapot {
+		t.Errorf("expected status %d, got %d", http.StatusTeapot, rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	if err := handler(echoInstance.NewContext(newSignedWebhookRequest(testApplicantBody, testEchoSecretKey), rec)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+}
+
+func TestWebhookRouter_WithVerification(t *testing.T) {
+	t.Parallel()
+
+	var options gosumsub.WebhookMiddlewareOptions
+
+	options.MaxBodyBytes = 16
+
+	router := gosumsub.NewWebhookRouter(testSecretKey, gosumsub.WithWebhookVerification(options))
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, newSignedWebhookRequest(testReviewedWebhookBody, testSecretKey))
+
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected status %d, got %d", http.StatusRequestEntityTooLarge, rec.Code)
+	}
+}
+
+func TestVerifyWebhookRequest_MissingHeaderSentinels(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(testApplicantBody))
+	req.Header.Set(gosumsub.HeaderDigestAlg, gosumsub.AlgoHMACSHA256)
+
+	if err := gosumsub.VerifyWebhookRequest(req, testSecretKey); !errors.Is(err, gosumsub.ErrMissingDigest) {
+		t.Errorf("expected ErrMissingDigest, got %v", err)
+	}
+}
@@ -0,0 +1,268 @@
+// Package webhooktest holds conformance tests for webhook middlewares, so every framework adapter is
+// checked against the same verification, body re-buffering and context injection behavior.
+package webhooktest
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/andyle182810/gosumsub"
+)
+
+const (
+	SecretKey = "webhooktest-secret"
+	// EventPayload is a signed applicantReviewed event used by the conformance tests.
+	EventPayload = `{"applicantId":"app123","correlationId":"corr789","type":"applicantReviewed",` +
+		`"reviewStatus":"completed","createdAtMs":"2024-03-05 10:20:30.456"}`
+	nonEventPayload = `{"applicantId":"app123"}`
+)
+
+// Observation is what the handler behind the middleware saw.
+type Observation struct {
+	// Body is read from the request body after the middleware ran.
+	Body []byte
+	// ContextBody and Event are read from the context the adapter injects them into.
+	ContextBody []byte
+	Event       *gosumsub.WebhookEvent
+}
+
+// Setup builds a server whose handler is protected by the middleware under test, configured with
+// secretKey and options. The handler must pass what it saw to observe and respond with 200. The
+// returned function sends a request to the server.
+type Setup func(
+	t *testing.T,
+	secretKey string,
+	options gosumsub.WebhookMiddlewareOptions,
+	observe func(Observation),
+) func(*http.Request) (*http.Response, error)
+
+// NewSignedRequest returns a webhook POST request for payload with an HMAC-SHA256 digest.
+func NewSignedRequest(payload, secretKey string) *http.Request {
+	digest, _ := gosumsub.SignWebhook([]byte(payload), secretKey, gosumsub.AlgoHMACSHA256)
+
+	request := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(gosumsub.HeaderDigestAlg, gosumsub.AlgoHMACSHA256)
+	request.Header.Set(gosumsub.HeaderDigest, digest)
+
+	return request
+}
+
+// RunMiddlewareConformance runs the conformance tests against the middleware built by setup.
+func RunMiddlewareConformance(t *testing.T, setup Setup) {
+	t.Helper()
+
+	t.Run("ValidSignature", func(t *testing.T) { testValidSignature(t, setup) })
+	t.Run("InvalidSignature", func(t *testing.T) { testInvalidSignature(t, setup) })
+	t.Run("MissingDigest", func(t *testing.T) { testMissingDigest(t, setup) })
+	t.Run("NonEventPayload", func(t *testing.T) { testNonEventPayload(t, setup) })
+	t.Run("BodyTooLarge", func(t *testing.T) { testBodyTooLarge(t, setup) })
+	t.Run("OnError", func(t *testing.T) { testOnError(t, setup) })
+	t.Run("WriteError", func(t *testing.T) { testWriteError(t, setup) })
+}
+
+type recorder struct {
+	mu           sync.Mutex
+	observations []Observation
+}
+
+func (r *recorder) observe(observation Observation) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.observations = append(r.observations, observation)
+}
+
+func (r *recorder) recorded() []Observation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Observation(nil), r.observations...)
+}
+
+func send(t *testing.T, do func(*http.Request) (*http.Response, error), request *http.Request) (int, string) {
+	t.Helper()
+
+	response, err := do(request)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("failed to read response: %v", err)
+	}
+
+	return response.StatusCode, string(body)
+}
+
+func defaultOptions() gosumsub.WebhookMiddlewareOptions {
+	var options gosumsub.WebhookMiddlewareOptions
+
+	return options
+}
+
+func testValidSignature(t *testing.T, setup Setup) {
+	t.Helper()
+
+	rec := &recorder{mu: sync.Mutex{}, observations: nil}
+	do := setup(t, SecretKey, defaultOptions(), rec.observe)
+
+	status, _ := send(t, do, NewSignedRequest(EventPayload, SecretKey))
+	if status != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, status)
+	}
+
+	observations := rec.recorded()
+	if len(observations) != 1 {
+		t.Fatalf("expected the handler to run once, got %d", len(observations))
+	}
+
+	observation := observations[0]
+
+	if !bytes.Equal(observation.Body, []byte(EventPayload)) {
+		t.Errorf("expected the body to be readable again, got %q", observation.Body)
+	}
+
+	if !bytes.Equal(observation.ContextBody, []byte(EventPayload)) {
+		t.Errorf("expected the verified body in context, got %q", observation.ContextBody)
+	}
+
+	if observation.Event == nil || observation.Event.Type != gosumsub.WebhookTypeApplicantReviewed {
+		t.Errorf("expected the parsed event in context, got %+v", observation.Event)
+	}
+}
+
+func testInvalidSignature(t *testing.T, setup Setup) {
+	t.Helper()
+
+	rec := &recorder{mu: sync.Mutex{}, observations: nil}
+	do := setup(t, SecretKey, defaultOptions(), rec.observe)
+
+	status, _ := send(t, do, NewSignedRequest(EventPayload, "wrong-secret"))
+	if status != http.StatusUnauthorized {
+		t.Errorf("expected status %d, got %d", http.StatusUnauthorized, status)
+	}
+
+	if got := len(rec.recorded()); got != 0 {
+		t.Errorf("expected the handler not to run, got %d calls", got)
+	}
+}
+
+func testMissingDigest(t *testing.T, setup Setup) {
+	t.Helper()
+
+	rec := &recorder{mu: sync.Mutex{}, observations: nil}
+	do := setup(t, SecretKey, defaultOptions(), rec.observe)
+
+	request := NewSignedRequest(EventPayload, SecretKey)
+	request.Header.Del(gosumsub.HeaderDigest)
+
+	status, _ := send(t, do, request)
+	if status != http.StatusUnauthorized {
+		t.Errorf("expected status %d, got %d", http.StatusUnauthorized, status)
+	}
+
+	if got := len(rec.recorded()); got != 0 {
+		t.Errorf("expected the handler not to run, got %d calls", got)
+	}
+}
+
+func testNonEventPayload(t *testing.T, setup Setup) {
+	t.Helper()
+
+	rec := &recorder{mu: sync.Mutex{}, observations: nil}
+	do := setup(t, SecretKey, defaultOptions(), rec.observe)
+
+	status, _ := send(t, do, NewSignedRequest(nonEventPayload, SecretKey))
+	if status != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, status)
+	}
+
+	observations := rec.recorded()
+	if len(observations) != 1 {
+		t.Fatalf("expected the handler to run once, got %d", len(observations))
+	}
+
+	if observations[0].Event != nil {
+		t.Errorf("expected no event for a payload without type, got %+v", observations[0].Event)
+	}
+
+	if !bytes.Equal(observations[0].ContextBody, []byte(nonEventPayload)) {
+		t.Errorf("expected the verified body in context, got %q", observations[0].ContextBody)
+	}
+}
+
+func testBodyTooLarge(t *testing.T, setup Setup) {
+	t.Helper()
+
+	options := defaultOptions()
+	options.MaxBodyBytes = 16
+
+	rec := &recorder{mu: sync.Mutex{}, observations: nil}
+	do := setup(t, SecretKey, options, rec.observe)
+
+	status, _ := send(t, do, NewSignedRequest(EventPayload, SecretKey))
+	if status != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected status %d, got %d", http.StatusRequestEntityTooLarge, status)
+	}
+
+	if got := len(rec.recorded()); got != 0 {
+		t.Errorf("expected the handler not to run, got %d calls", got)
+	}
+}
+
+func testOnError(t *testing.T, setup Setup) {
+	t.Helper()
+
+	var (
+		mu      sync.Mutex
+		errs    []error
+		options = defaultOptions()
+	)
+
+	options.OnError = func(_ *http.Request, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		errs = append(errs, err)
+	}
+
+	rec := &recorder{mu: sync.Mutex{}, observations: nil}
+	do := setup(t, SecretKey, options, rec.observe)
+
+	send(t, do, NewSignedRequest(EventPayload, "wrong-secret"))
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(errs) != 1 || !errors.Is(errs[0], gosumsub.ErrDigestMismatch) {
+		t.Errorf("expected OnError to receive ErrDigestMismatch, got %v", errs)
+	}
+}
+
+func testWriteError(t *testing.T, setup Setup) {
+	t.Helper()
+
+	options := defaultOptions()
+	options.WriteError = func(writer http.ResponseWriter, _ *http.Request, err error) {
+		writer.Header().Set("X-Webhook-Error", err.Error())
+		writer.WriteHeader(http.StatusTeapot)
+		_, _ = writer.Write([]byte("custom"))
+	}
+
+	rec := &recorder{mu: sync.Mutex{}, observations: nil}
+	do := setup(t, SecretKey, options, rec.observe)
+
+	status, body := send(t, do, NewSignedRequest(EventPayload, "wrong-secret"))
+	if status != http.StatusTeapot || body != "custom" {
+		t.Errorf("expected custom %d response, got %d %q", http.StatusTeapot, status, body)
+	}
+}